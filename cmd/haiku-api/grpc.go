@@ -1,6 +1,9 @@
 package main
 
 import (
+	"context"
+	"time"
+
 	"github.com/go-logr/logr"
 	v1 "github.com/mhelmich/haiku-api/pkg/api/v1"
 	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
@@ -9,7 +12,7 @@ import (
 	"google.golang.org/grpc/credentials"
 )
 
-func registerServices(configPath string, logger logr.Logger) (*grpc.Server, error) {
+func registerServices(configPath string, reapInterval time.Duration, buildInterval time.Duration, logger logr.Logger) (*grpc.Server, error) {
 	srvr, err := newGrpcServer()
 	if err != nil {
 		return nil, err
@@ -21,6 +24,9 @@ func registerServices(configPath string, logger logr.Logger) (*grpc.Server, erro
 	}
 
 	pb.RegisterCliServiceServer(srvr, cliSrvr)
+	cliSrvr.AdoptLegacyEnvironments(context.Background())
+	go cliSrvr.ReapExpiredEnvironments(context.Background(), reapInterval)
+	go cliSrvr.ReconcileBuilds(context.Background(), buildInterval)
 	return srvr, nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"time"
 )
 
 var (
@@ -13,6 +14,8 @@ var (
 	// flip the default to this in order to connect to the cluster
 	// /Users/marco/.kube/config
 	kubeConfigPath = flag.String("kube-config-path", "", "(optional) the path to the kube config file to be used")
+	reapInterval   = flag.Duration("reap-interval", time.Minute, "how often expired environments are looked for")
+	buildInterval  = flag.Duration("build-interval", 5*time.Second, "how often finished builds are looked for to deploy them")
 )
 
 func main() {
@@ -31,7 +34,7 @@ func main() {
 	}
 
 	logger.Info(fmt.Sprintf("kube.config: %s", *kubeConfigPath))
-	srvr, err := registerServices(*kubeConfigPath, logger)
+	srvr, err := registerServices(*kubeConfigPath, *reapInterval, *buildInterval, logger)
 	if err != nil {
 		logger.Error(err, "failed to listen")
 		return
@@ -0,0 +1,297 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/manifest"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Apply converges an environment to a manifest.
+// The environment is created if it doesn't exist, services are deployed if their image or settings differ
+// and their env is replaced if it differs. Services that aren't in the manifest are only deleted when pruning.
+// Registry logins aren't part of a manifest and are left alone.
+// Everything is validated before the first change is made, so a bad manifest is rejected without touching the environment.
+// Changes are made service by service though. If one fails, the services before it stay changed,
+// applying the manifest again picks up where it stopped.
+func (s *CliServer) Apply(ctx context.Context, req *pb.ApplyRequest) (*pb.ApplyReply, error) {
+	m, err := manifest.Parse([]byte(req.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArgument, err.Error())
+	}
+
+	namespaceName, err := getK8sNamespaceForHaikuSpaceName(m.Metadata.Name)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("apply manifest", "dryRun", req.DryRun, "prune", req.Prune)
+	if m.Spec.Tier != "" {
+		if _, ok := tiers[m.Spec.Tier]; !ok {
+			return nil, fmt.Errorf("%w: unknown tier %s", ErrInvalidArgument, m.Spec.Tier)
+		}
+	}
+
+	spec := toEnvironmentSpec(m)
+	for _, svc := range spec.Services {
+		for _, env := range []map[string]string{svc.Env, svc.SecretEnv} {
+			for key := range env {
+				if errs := validation.IsEnvVarName(key); len(errs) > 0 {
+					return nil, fmt.Errorf("%w: service %s: %s: %s", ErrInvalidArgument, svc.Name, key, strings.Join(errs, ", "))
+				}
+			}
+		}
+	}
+
+	desired, err := specState(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	current := &environmentState{
+		Services:       map[string]*pb.ServiceSpec{},
+		RegistryLogins: map[string]string{},
+	}
+	namespace, err := s.getEnvironment(ctx, m.Metadata.Name)
+	created := err != nil && IsNotFound(err)
+	if err != nil && !created {
+		logger.Error(err, "failed to get environment")
+		return nil, err
+	} else if !created {
+		// legacy environments live in a namespace without the prefix
+		namespaceName = namespace.Name
+		current, err = s.readEnvironmentState(ctx, m.Metadata.Name)
+		if err != nil {
+			logger.Error(err, "failed to read environment")
+			return nil, err
+		}
+	}
+	desired.RegistryLogins = current.RegistryLogins
+
+	diff := diffEnvironmentStates(current, desired)
+	if !req.Prune {
+		kept := diff.Services[:0]
+		for _, serviceDiff := range diff.Services {
+			if serviceDiff.Type != pb.EnvChange_REMOVED {
+				kept = append(kept, serviceDiff)
+			}
+		}
+		diff.Services = kept
+		diff.Identical = len(diff.Services) == 0
+	}
+
+	// secrets given as hashes need to be resolved to the actual values
+	secretEnvs := map[string]map[string]string{}
+	for _, svc := range spec.Services {
+		err = s.validateSettings(ctx, namespaceName, svc.Settings)
+		if err != nil {
+			return nil, err
+		}
+		secretEnvs[svc.Name], err = s.resolveSecretEnv(ctx, namespaceName, created, svc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tierChanged := !created && m.Spec.Tier != "" && namespace.Labels[labelTier] != m.Spec.Tier
+	reply := &pb.ApplyReply{
+		Created: created,
+		Diff:    diff,
+	}
+	if req.DryRun {
+		return reply, nil
+	}
+
+	if created {
+		_, err = s.Init(ctx, &pb.InitRequest{
+			EnvironmentName: m.Metadata.Name,
+			Tier:            m.Spec.Tier,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else if tierChanged {
+		_, err = s.SetEnvironmentTier(ctx, &pb.SetEnvironmentTierRequest{
+			EnvironmentName: m.Metadata.Name,
+			Tier:            m.Spec.Tier,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	deployed := []*v1alpha1.Service{}
+	revisions := []int64{}
+	for _, serviceDiff := range diff.Services {
+		serviceLogger := logger.WithValues("serviceName", serviceDiff.Name)
+		if serviceDiff.Type == pb.EnvChange_REMOVED {
+			err = s.deleteService(ctx, namespaceName, serviceDiff.Name, serviceLogger)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		svc := desired.Services[serviceDiff.Name]
+		redeploy := serviceDiff.Type == pb.EnvChange_ADDED || len(serviceDiff.Fields) > 0
+		if len(serviceDiff.Env) > 0 {
+			_, err = s.replaceEnv(ctx, namespaceName, svc.Name, svc.Env, secretEnvs[svc.Name])
+			// a deploy picks the env up, an env change alone needs a rollout of its own
+			if err == nil && !redeploy {
+				err = s.rolloutEnv(ctx, namespaceName, svc.Name)
+			}
+			if err != nil {
+				serviceLogger.Error(err, "failed to update env")
+				return nil, err
+			}
+		}
+
+		if !redeploy {
+			continue
+		}
+
+		service, revision, err := s.deploy(ctx, namespaceName, &pb.DeployRequest{
+			Image:           svc.Image,
+			EnvironmentName: m.Metadata.Name,
+			ServiceName:     svc.Name,
+			Settings:        svc.Settings,
+		})
+		if err != nil {
+			serviceLogger.Error(err, "failed to deploy service")
+			return nil, err
+		}
+		deployed = append(deployed, service)
+		revisions = append(revisions, revision)
+	}
+
+	for idx, service := range deployed {
+		ready, err := s.waitForService(ctx, service, logger.WithValues("serviceName", service.Name))
+		if err != nil {
+			logger.Error(err, "failed to watch service", "serviceName", service.Name)
+			return nil, err
+		}
+		info := toServiceInfo(ready, revisions[idx])
+		reply.Services = append(reply.Services, info)
+	}
+	return reply, nil
+}
+
+// Export renders an environment as manifest.
+// Secret env values are exported as hashes, applying the export as is doesn't change anything.
+func (s *CliServer) Export(ctx context.Context, req *pb.ExportRequest) (*pb.ExportReply, error) {
+	namespace, err := s.getEnvironment(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespace.Name, "requestID", requestid.FromContext(ctx))
+	logger.Info("export environment")
+
+	state, err := s.readEnvironmentState(ctx, req.EnvironmentName)
+	if err != nil {
+		logger.Error(err, "failed to read environment")
+		return nil, err
+	}
+
+	m := &manifest.Manifest{
+		APIVersion: manifest.APIVersion,
+		Kind:       manifest.Kind,
+		Metadata: manifest.Metadata{
+			Name: req.EnvironmentName,
+		},
+		Spec: manifest.Spec{
+			Tier: namespace.Labels[labelTier],
+		},
+	}
+	for _, svc := range state.Services {
+		m.Spec.Services = append(m.Spec.Services, toManifestService(svc))
+	}
+	sort.Slice(m.Spec.Services, func(i, j int) bool {
+		return m.Spec.Services[i].Name < m.Spec.Services[j].Name
+	})
+
+	bites, err := manifest.Marshal(m)
+	if err != nil {
+		logger.Error(err, "failed to render manifest")
+		return nil, err
+	}
+
+	return &pb.ExportReply{
+		Manifest: string(bites),
+	}, nil
+}
+
+// resolveSecretEnv returns the secret env of a service spec with hashes replaced by the secrets they stand for.
+// A hash can only stand for the secret the service has right now.
+func (s *CliServer) resolveSecretEnv(ctx context.Context, namespaceName string, created bool, svc *pb.ServiceSpec) (map[string]string, error) {
+	currentSecretEnv := map[string]string{}
+	if !created {
+		var err error
+		_, currentSecretEnv, err = s.getEnv(ctx, namespaceName, svc.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secretEnv := make(map[string]string, len(svc.SecretEnv))
+	for key, value := range svc.SecretEnv {
+		if !isSecretHash(value) {
+			secretEnv[key] = value
+			continue
+		}
+		current, ok := currentSecretEnv[key]
+		if !ok || hashSecret(current) != value {
+			return nil, fmt.Errorf("%w: secret env %s of service %s is given as hash that doesn't match the current value", ErrInvalidArgument, key, svc.Name)
+		}
+		secretEnv[key] = current
+	}
+	return secretEnv, nil
+}
+
+func toEnvironmentSpec(m *manifest.Manifest) *pb.EnvironmentSpec {
+	spec := &pb.EnvironmentSpec{}
+	for _, svc := range m.Spec.Services {
+		settings := &pb.ServiceSettings{}
+		if svc.Scaling != nil {
+			settings = &pb.ServiceSettings{
+				CPU:         svc.Scaling.CPU,
+				Memory:      svc.Scaling.Memory,
+				MinReplicas: svc.Scaling.MinReplicas,
+				MaxReplicas: svc.Scaling.MaxReplicas,
+				Concurrency: svc.Scaling.Concurrency,
+			}
+		}
+		spec.Services = append(spec.Services, &pb.ServiceSpec{
+			Name:      svc.Name,
+			Image:     svc.Image,
+			Env:       svc.Env,
+			SecretEnv: svc.SecretEnv,
+			Settings:  settings,
+		})
+	}
+	return spec
+}
+
+func toManifestService(svc *pb.ServiceSpec) manifest.Service {
+	service := manifest.Service{
+		Name:      svc.Name,
+		Image:     svc.Image,
+		Env:       svc.Env,
+		SecretEnv: svc.SecretEnv,
+	}
+	if settings := svc.Settings; settings != nil && (settings.CPU != "" || settings.Memory != "" || settings.MinReplicas != 0 || settings.MaxReplicas != 0 || settings.Concurrency != 0) {
+		service.Scaling = &manifest.Scaling{
+			CPU:         settings.CPU,
+			Memory:      settings.Memory,
+			MinReplicas: settings.MinReplicas,
+			MaxReplicas: settings.MaxReplicas,
+			Concurrency: settings.Concurrency,
+		}
+	}
+	return service
+}
@@ -0,0 +1,593 @@
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	ho "github.com/mhelmich/haiku-operator/apis/entities/v1alpha1"
+	tekton "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"knative.dev/pkg/apis"
+)
+
+// Stages of a build as reported to the client.
+// Fetch and build are tasks of the pipeline, the build reconciler deploys once the pipeline is done.
+const (
+	stageFetch  = "fetch"
+	stageBuild  = "build"
+	stageDeploy = "deploy"
+)
+
+// the order in which the tasks of the pipeline run
+var buildStages = []string{stageFetch, stageBuild}
+
+const (
+	buildParamArchiveURL       = "archive-url"
+	buildParamImage            = "image"
+	buildWorkspaceSource       = "source"
+	buildWorkspaceDockerConfig = "docker-config"
+	buildResultImageDigest     = "image-digest"
+
+	buildTimeout    = 30 * time.Minute
+	buildVolumeSize = "1Gi"
+	// tekton labels pods with the name of the pipeline run, it has to fit into a label value
+	maxBuildServiceNameLength = 40
+
+	dockerHubRegistry = "docker.io"
+	dockerHubAuthKey  = "https://index.docker.io/v1/"
+)
+
+// images the steps of the pipeline run in
+const (
+	fetchImage      = "google/cloud-sdk:367.0.0-slim"
+	kanikoImage     = "gcr.io/kaniko-project/executor:v1.7.0-debug"
+	buildpacksImage = "paketobuildpacks/builder:base"
+)
+
+const fetchScript = `#!/usr/bin/env bash
+set -euo pipefail
+curl --fail --silent --show-error --location --output /tmp/archive.zip "$(params.archive-url)"
+python3 -m zipfile -e /tmp/archive.zip "$(workspaces.source.path)"
+# buildpacks don't run as root
+chmod -R a+rwX "$(workspaces.source.path)"
+`
+
+const kanikoScript = `#!/busybox/sh
+set -e
+if [ ! -f "$(workspaces.source.path)/Dockerfile" ]; then
+  echo "no Dockerfile found, building with buildpacks"
+  exit 0
+fi
+/kaniko/executor \
+  --context="$(workspaces.source.path)" \
+  --destination="$(params.image)" \
+  --digest-file="$(results.image-digest.path)"
+`
+
+const buildpacksScript = `#!/usr/bin/env bash
+set -euo pipefail
+if [ -f "$(workspaces.source.path)/Dockerfile" ]; then
+  exit 0
+fi
+/cnb/lifecycle/creator \
+  -app="$(workspaces.source.path)" \
+  -layers=/layers \
+  -report=/layers/report.toml \
+  "$(params.image)"
+sed -n 's/^ *digest = "\(.*\)"$/\1/p' /layers/report.toml | tr -d '\n' > "$(results.image-digest.path)"
+`
+
+// startBuild kicks off a pipeline run that builds the uploaded archive and pushes the image
+// to the registry of the first docker login of the environment.
+// The build is named after the service and its name doubles as build id and image tag.
+func (s *CliServer) startBuild(ctx context.Context, namespaceName string, environmentName string, serviceName string, key string, logger logr.Logger) (*tekton.PipelineRun, error) {
+	logins, err := s.haikuClient.EntitiesV1alpha1().DockerLogins(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Error(err, "failed to list dockerlogins")
+		return nil, err
+	} else if len(logins.Items) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "environment %s has no registry to push to, add one with docker login", environmentName)
+	}
+
+	// the oldest login decides where the image goes
+	sort.Slice(logins.Items, func(i, j int) bool {
+		return logins.Items[i].CreationTimestamp.Before(&logins.Items[j].CreationTimestamp)
+	})
+	dockerConfig, err := dockerConfigJSON(logins.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	buildName := newBuildName(serviceName)
+	repository := imageRepository(logins.Items[0].Spec, environmentName, serviceName)
+	// the fetch step has no access to the bucket of its own
+	archiveURL, err := s.gcsClient.Bucket(s.uploadBucket).SignedURL(key, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  "GET",
+		Expires: time.Now().Add(buildTimeout),
+	})
+	if err != nil {
+		logger.Error(err, "failed to sign archive url")
+		return nil, err
+	}
+
+	runAnnotations := map[string]string{
+		annotationSourceKey:       key,
+		annotationBuildRepository: repository,
+		annotationCreatedBy:       callerFromContext(ctx),
+		annotationRequestID:       requestid.FromContext(ctx),
+	}
+	// if the service is deleted while it's being built, the build isn't deployed (see serviceDeleted)
+	service, err := s.haikuClient.ServingV1alpha1().Services(namespaceName).Get(ctx, serviceName, metav1.GetOptions{})
+	if err == nil {
+		runAnnotations[annotationServiceUID] = string(service.UID)
+	} else if !errors.IsNotFound(err) {
+		logger.Error(err, "failed to get service")
+		return nil, err
+	}
+
+	runLabels := serviceLabels(serviceName, componentBuild)
+	runLabels[labelBuildPhase] = buildPhaseBuilding
+	run, err := s.tektonClient.TektonV1beta1().PipelineRuns(namespaceName).Create(ctx, &tekton.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        buildName,
+			Labels:      runLabels,
+			Annotations: runAnnotations,
+		},
+		Spec: tekton.PipelineRunSpec{
+			PipelineSpec: buildPipelineSpec(),
+			Params: []tekton.Param{
+				{Name: buildParamArchiveURL, Value: *tekton.NewArrayOrString(archiveURL)},
+				{Name: buildParamImage, Value: *tekton.NewArrayOrString(repository + ":" + buildName)},
+			},
+			Workspaces: []tekton.WorkspaceBinding{
+				{
+					Name: buildWorkspaceSource,
+					VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+						Spec: corev1.PersistentVolumeClaimSpec{
+							AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceStorage: resource.MustParse(buildVolumeSize),
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: buildWorkspaceDockerConfig,
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: registrySecretName(buildName),
+						Items: []corev1.KeyToPath{
+							{Key: corev1.DockerConfigJsonKey, Path: "config.json"},
+						},
+					},
+				},
+			},
+			Timeout: &metav1.Duration{Duration: buildTimeout},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		logger.Error(err, "failed to create pipeline run")
+		return nil, err
+	}
+
+	// the registry secret is created owned by the build, so it goes away with the build no matter what
+	// the build pod waits for the secret to show up
+	_, err = s.k8sClient.CoreV1().Secrets(namespaceName).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   registrySecretName(buildName),
+			Labels: serviceLabels(serviceName, componentBuild),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: tekton.SchemeGroupVersion.String(),
+					Kind:       "PipelineRun",
+					Name:       run.Name,
+					UID:        run.UID,
+				},
+			},
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: dockerConfig,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		logger.Error(err, "failed to create registry secret")
+		// a build without registry secret can't push, don't leave it hanging around
+		deleteErr := s.tektonClient.TektonV1beta1().PipelineRuns(namespaceName).Delete(ctx, run.Name, metav1.DeleteOptions{})
+		if deleteErr != nil && !errors.IsNotFound(deleteErr) {
+			logger.Error(deleteErr, "failed to delete pipeline run")
+		}
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// followBuild reports the stages of a build as they start and finish, along with the output of their steps.
+// Once the image is pushed, the build reconciler deploys it. That's reported as deploy stage.
+// Following a build is watching only, the build and its deploy go on without anybody following.
+// It returns the url of the service once the build is deployed.
+func (s *CliServer) followBuild(ctx context.Context, run *tekton.PipelineRun, send func(*pb.DeploymentUpdate) error, logger logr.Logger) (string, error) {
+	watcher, err := s.tektonClient.TektonV1beta1().PipelineRuns(run.Namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", run.Name).String(),
+		ResourceVersion: run.ResourceVersion,
+	})
+	if err != nil {
+		logger.Error(err, "failed to create watcher for pipeline run")
+		return "", err
+	}
+	defer watcher.Stop()
+
+	ctx, cancel := context.WithCancel(ctx)
+	log := s.newBuildLog(run, send, logger)
+	defer func() {
+		// stop tailing when we're done here, one way or another
+		cancel()
+		log.wg.Wait()
+	}()
+
+	reported := map[string]pb.DeploymentUpdate_StageStatus{}
+	logClosed := false
+	for {
+		select {
+		case <-ctx.Done():
+			// request timed out
+			return "", status.Error(codes.DeadlineExceeded, "timed out waiting for the build")
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return "", status.Error(codes.Unavailable, "lost track of the build")
+			} else if event.Type == watch.Deleted {
+				return "", status.Errorf(codes.Aborted, "build %s was deleted", run.Name)
+			}
+			current, ok := event.Object.(*tekton.PipelineRun)
+			if !ok {
+				logger.Error(fmt.Errorf("object was %T", event.Object), "couldn't cast event watcher object to pipeline run")
+				continue
+			}
+
+			if !logClosed {
+				log.follow(ctx, current)
+			}
+			for _, update := range stageUpdates(current) {
+				if last, ok := reported[update.Stage]; ok && last == update.Status {
+					continue
+				}
+				reported[update.Stage] = update.Status
+				err = log.send(update)
+				if err != nil {
+					return "", err
+				}
+			}
+
+			image, done, err := buildResult(current)
+			if !done {
+				continue
+			}
+			if !logClosed {
+				// all output makes it to the client before the outcome
+				log.close()
+				logClosed = true
+			}
+
+			if isBuildCancelled(current) || current.Labels[labelBuildPhase] == buildPhaseCancelled {
+				err = log.send(&pb.DeploymentUpdate{
+					Message:   cancelledMessage(current),
+					BuildID:   current.Name,
+					Status:    pb.DeploymentUpdate_CANCELLED,
+					Timestamp: time.Now().Unix(),
+				})
+				if err != nil {
+					return "", err
+				}
+				return "", errBuildCancelled
+			} else if err != nil {
+				return "", err
+			}
+
+			switch current.Labels[labelBuildPhase] {
+			case buildPhaseDeploying:
+				if _, ok := reported[stageDeploy]; ok {
+					continue
+				}
+				reported[stageDeploy] = pb.DeploymentUpdate_STARTED
+				err = log.send(&pb.DeploymentUpdate{
+					Message:   fmt.Sprintf("deploying %s", image),
+					BuildID:   current.Name,
+					Stage:     stageDeploy,
+					Status:    pb.DeploymentUpdate_STARTED,
+					Timestamp: time.Now().Unix(),
+					Image:     image,
+				})
+				if err != nil {
+					return "", err
+				}
+			case buildPhaseDeployed:
+				url := current.Annotations[annotationBuildURL]
+				err = log.send(&pb.DeploymentUpdate{
+					Message:   fmt.Sprintf("service %s is serving at %s", current.Labels[labelService], url),
+					BuildID:   current.Name,
+					Stage:     stageDeploy,
+					Status:    pb.DeploymentUpdate_SUCCEEDED,
+					Timestamp: time.Now().Unix(),
+					Image:     image,
+					URL:       url,
+				})
+				if err != nil {
+					return "", err
+				}
+				return url, nil
+			case buildPhaseFailed:
+				return "", sendStageFailed(log.send, current.Name, stageDeploy, status.Errorf(codes.Aborted, "deploy of build %s failed: %s", current.Name, current.Annotations[annotationBuildMessage]))
+			}
+		}
+	}
+}
+
+// stageUpdates translates the task runs of a pipeline run into updates, in the order the tasks run.
+// Tasks that didn't start yet are left out.
+func stageUpdates(run *tekton.PipelineRun) []*pb.DeploymentUpdate {
+	taskRuns := map[string]*tekton.TaskRunStatus{}
+	for _, taskRun := range run.Status.TaskRuns {
+		taskRuns[taskRun.PipelineTaskName] = taskRun.Status
+	}
+
+	updates := []*pb.DeploymentUpdate{}
+	for _, stage := range buildStages {
+		taskRun, ok := taskRuns[stage]
+		if !ok || taskRun == nil || taskRun.StartTime == nil {
+			continue
+		}
+
+		update := &pb.DeploymentUpdate{
+			Message:   fmt.Sprintf("%s started", stage),
+			BuildID:   run.Name,
+			Stage:     stage,
+			Status:    pb.DeploymentUpdate_STARTED,
+			Timestamp: taskRun.StartTime.Unix(),
+		}
+		condition := taskRun.GetCondition(apis.ConditionSucceeded)
+		if condition.IsTrue() {
+			update.Message = fmt.Sprintf("%s succeeded", stage)
+			update.Status = pb.DeploymentUpdate_SUCCEEDED
+		} else if condition.IsFalse() && condition.Reason == tekton.TaskRunReasonCancelled.String() {
+			update.Message = fmt.Sprintf("%s cancelled", stage)
+			update.Status = pb.DeploymentUpdate_CANCELLED
+		} else if condition.IsFalse() {
+			update.Message = condition.Message
+			update.Status = pb.DeploymentUpdate_FAILED
+		}
+		if update.Status != pb.DeploymentUpdate_STARTED && taskRun.CompletionTime != nil {
+			update.Timestamp = taskRun.CompletionTime.Unix()
+		}
+		updates = append(updates, update)
+	}
+	return updates
+}
+
+// buildResult tells whether a pipeline run is done and which image it produced.
+func buildResult(run *tekton.PipelineRun) (string, bool, error) {
+	condition := run.Status.GetCondition(apis.ConditionSucceeded)
+	if condition == nil || condition.IsUnknown() {
+		return "", false, nil
+	} else if condition.IsFalse() {
+		return "", true, status.Errorf(codes.Aborted, "build %s failed: %s", run.Name, condition.Message)
+	}
+
+	digest := buildImageDigest(run)
+	if digest == "" {
+		return "", true, status.Errorf(codes.Internal, "build %s didn't report an image digest", run.Name)
+	}
+	return run.Annotations[annotationBuildRepository] + "@" + digest, true, nil
+}
+
+func cancelledMessage(run *tekton.PipelineRun) string {
+	if cancelledBy := run.Annotations[annotationCancelledBy]; cancelledBy != "" {
+		return fmt.Sprintf("build %s was cancelled by %s", run.Name, cancelledBy)
+	}
+	return fmt.Sprintf("build %s was cancelled", run.Name)
+}
+
+func buildImageDigest(run *tekton.PipelineRun) string {
+	for _, result := range run.Status.PipelineResults {
+		if result.Name == buildResultImageDigest {
+			return strings.TrimSpace(result.Value)
+		}
+	}
+	return ""
+}
+
+func sendStageFailed(send func(*pb.DeploymentUpdate) error, buildID string, stage string, err error) error {
+	sendErr := send(&pb.DeploymentUpdate{
+		Message:   err.Error(),
+		BuildID:   buildID,
+		Stage:     stage,
+		Status:    pb.DeploymentUpdate_FAILED,
+		Timestamp: time.Now().Unix(),
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	return err
+}
+
+// buildPipelineSpec fetches the archive into a workspace and builds it.
+// Archives with a Dockerfile are built by kaniko, everything else by buildpacks.
+// Either way the digest of the pushed image ends up in the image-digest result.
+func buildPipelineSpec() *tekton.PipelineSpec {
+	dockerConfigEnv := []corev1.EnvVar{
+		{Name: "DOCKER_CONFIG", Value: fmt.Sprintf("$(workspaces.%s.path)", buildWorkspaceDockerConfig)},
+	}
+
+	return &tekton.PipelineSpec{
+		Params: []tekton.ParamSpec{
+			{Name: buildParamArchiveURL, Type: tekton.ParamTypeString},
+			{Name: buildParamImage, Type: tekton.ParamTypeString},
+		},
+		Workspaces: []tekton.PipelineWorkspaceDeclaration{
+			{Name: buildWorkspaceSource},
+			{Name: buildWorkspaceDockerConfig},
+		},
+		Tasks: []tekton.PipelineTask{
+			{
+				Name: stageFetch,
+				TaskSpec: &tekton.EmbeddedTask{
+					TaskSpec: tekton.TaskSpec{
+						Params: []tekton.ParamSpec{
+							{Name: buildParamArchiveURL, Type: tekton.ParamTypeString},
+						},
+						Workspaces: []tekton.WorkspaceDeclaration{
+							{Name: buildWorkspaceSource},
+						},
+						Steps: []tekton.Step{
+							{
+								Container: corev1.Container{Name: stageFetch, Image: fetchImage},
+								Script:    fetchScript,
+							},
+						},
+					},
+				},
+				Params: []tekton.Param{
+					{Name: buildParamArchiveURL, Value: *tekton.NewArrayOrString(fmt.Sprintf("$(params.%s)", buildParamArchiveURL))},
+				},
+				Workspaces: []tekton.WorkspacePipelineTaskBinding{
+					{Name: buildWorkspaceSource, Workspace: buildWorkspaceSource},
+				},
+			},
+			{
+				Name:     stageBuild,
+				RunAfter: []string{stageFetch},
+				TaskSpec: &tekton.EmbeddedTask{
+					TaskSpec: tekton.TaskSpec{
+						Params: []tekton.ParamSpec{
+							{Name: buildParamImage, Type: tekton.ParamTypeString},
+						},
+						Workspaces: []tekton.WorkspaceDeclaration{
+							{Name: buildWorkspaceSource},
+							{Name: buildWorkspaceDockerConfig, ReadOnly: true},
+						},
+						Results: []tekton.TaskResult{
+							{Name: buildResultImageDigest, Description: "the digest of the pushed image"},
+						},
+						Volumes: []corev1.Volume{
+							{Name: "layers", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+						},
+						Steps: []tekton.Step{
+							{
+								Container: corev1.Container{Name: "kaniko", Image: kanikoImage, Env: dockerConfigEnv},
+								Script:    kanikoScript,
+							},
+							{
+								Container: corev1.Container{
+									Name:  "buildpacks",
+									Image: buildpacksImage,
+									Env:   append(dockerConfigEnv, corev1.EnvVar{Name: "CNB_PLATFORM_API", Value: "0.7"}),
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "layers", MountPath: "/layers"},
+									},
+								},
+								Script: buildpacksScript,
+							},
+						},
+					},
+				},
+				Params: []tekton.Param{
+					{Name: buildParamImage, Value: *tekton.NewArrayOrString(fmt.Sprintf("$(params.%s)", buildParamImage))},
+				},
+				Workspaces: []tekton.WorkspacePipelineTaskBinding{
+					{Name: buildWorkspaceSource, Workspace: buildWorkspaceSource},
+					{Name: buildWorkspaceDockerConfig, Workspace: buildWorkspaceDockerConfig},
+				},
+			},
+		},
+		Results: []tekton.PipelineResult{
+			{
+				Name:        buildResultImageDigest,
+				Description: "the digest of the pushed image",
+				Value:       fmt.Sprintf("$(tasks.%s.results.%s)", stageBuild, buildResultImageDigest),
+			},
+		},
+	}
+}
+
+// registrySecretName is the name of the secret a build pushes its image with.
+func registrySecretName(buildName string) string {
+	return buildName + "-registry"
+}
+
+func newBuildName(serviceName string) string {
+	if len(serviceName) > maxBuildServiceNameLength {
+		serviceName = strings.TrimRight(serviceName[:maxBuildServiceNameLength], "-")
+	}
+	return fmt.Sprintf("build-%s-%s", serviceName, uuid.NewString()[:8])
+}
+
+// registryHost strips scheme and path off the server of a docker login.
+func registryHost(server string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	switch host {
+	case "", "index.docker.io", "registry-1.docker.io":
+		return dockerHubRegistry
+	}
+	return host
+}
+
+// imageRepository is where the images of a service are pushed to.
+func imageRepository(login ho.DockerLoginSpec, environmentName string, serviceName string) string {
+	host := registryHost(login.Server)
+	if host == dockerHubRegistry {
+		// docker hub doesn't do nested repositories
+		return fmt.Sprintf("%s/%s/%s-%s", host, login.Username, environmentName, serviceName)
+	}
+	return fmt.Sprintf("%s/%s/%s", host, environmentName, serviceName)
+}
+
+type dockerAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Email    string `json:"email,omitempty"`
+	Auth     string `json:"auth"`
+}
+
+// dockerConfigJSON renders docker logins the way docker keeps them in config.json.
+// If there are multiple logins for the same registry, the first one wins.
+func dockerConfigJSON(logins []ho.DockerLogin) ([]byte, error) {
+	auths := map[string]dockerAuth{}
+	for _, login := range logins {
+		key := registryHost(login.Spec.Server)
+		if key == dockerHubRegistry {
+			key = dockerHubAuthKey
+		}
+		if _, ok := auths[key]; ok {
+			continue
+		}
+		auths[key] = dockerAuth{
+			Username: login.Spec.Username,
+			Password: login.Spec.Password,
+			Email:    login.Spec.Email,
+			Auth:     base64.StdEncoding.EncodeToString([]byte(login.Spec.Username + ":" + login.Spec.Password)),
+		}
+	}
+	return json.Marshal(map[string]map[string]dockerAuth{"auths": auths})
+}
@@ -0,0 +1,244 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	tekton "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/util/retry"
+)
+
+// Phases of a build, they're kept in a label on its pipeline run.
+// The pipeline builds and pushes the image, the build reconciler deploys it once the pipeline succeeded.
+const (
+	buildPhaseBuilding  = "building"
+	buildPhaseDeploying = "deploying"
+	buildPhaseDeployed  = "deployed"
+	buildPhaseFailed    = "failed"
+	buildPhaseCancelled = "cancelled"
+)
+
+const (
+	// a deploy that takes longer than that is considered lost and taken over
+	buildDeployTimeout = 10 * time.Minute
+	// how long recording the outcome of a build may take
+	buildFinishTimeout = 30 * time.Second
+)
+
+// ReconcileBuilds deploys the images of finished builds every interval until the context is done.
+// It's meant to run in the background for as long as the server is up.
+// Deploys don't depend on anybody following the build, they happen here.
+// A build is claimed before it's deployed, so it's deployed once no matter how many servers are up.
+func (s *CliServer) ReconcileBuilds(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.reconcileBuilds(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *CliServer) reconcileBuilds(ctx context.Context) {
+	// every round gets a request id of its own so that its logs can be told apart
+	ctx = requestid.NewContext(ctx)
+	logger := s.logger.WithValues("requestID", requestid.FromContext(ctx))
+	pending, err := labels.NewRequirement(labelBuildPhase, selection.In, []string{buildPhaseBuilding, buildPhaseDeploying})
+	if err != nil {
+		logger.Error(err, "failed to select builds")
+		return
+	}
+
+	selector := labels.SelectorFromSet(map[string]string{
+		labelManagedBy: managedByHaikuAPI,
+		labelComponent: componentBuild,
+	}).Add(*pending)
+	runs, err := s.tektonClient.TektonV1beta1().PipelineRuns(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		logger.Error(err, "failed to list pipeline runs")
+		return
+	}
+
+	for idx := range runs.Items {
+		run := &runs.Items[idx]
+		s.reconcileBuild(ctx, run, logger.WithValues("namespaceName", run.Namespace, "buildID", run.Name))
+	}
+}
+
+// reconcileBuild moves a build on once its pipeline is done.
+// Deploys that are still going on elsewhere are left alone until they're overdue.
+func (s *CliServer) reconcileBuild(ctx context.Context, run *tekton.PipelineRun, logger logr.Logger) {
+	switch {
+	case run.Labels[labelBuildPhase] == buildPhaseDeploying:
+		if _, ok := s.deploying.Load(buildKey(run)); ok || !deployOverdue(run) {
+			return
+		}
+		logger.Info("taking over overdue deploy", "startedAt", run.Annotations[annotationDeployStartedAt])
+	case !run.IsDone():
+		return
+	default:
+		// the log is stored once, before the build moves on
+		s.storeBuildLog(ctx, run, logger)
+	}
+
+	image, _, err := buildResult(run)
+	if isBuildCancelled(run) {
+		s.finishBuild(ctx, run, buildPhaseCancelled, map[string]string{annotationBuildMessage: cancelledMessage(run)}, logger)
+		return
+	} else if err != nil {
+		s.finishBuild(ctx, run, buildPhaseFailed, map[string]string{annotationBuildMessage: err.Error()}, logger)
+		return
+	}
+
+	deleted, err := s.serviceDeleted(ctx, run)
+	if err != nil {
+		logger.Error(err, "failed to get service")
+		return
+	} else if deleted {
+		logger.Info("service was deleted since the build started")
+		s.finishBuild(ctx, run, buildPhaseCancelled, map[string]string{annotationBuildMessage: serviceDeletedMessage(run)}, logger)
+		return
+	}
+
+	run, err = s.claimBuild(ctx, run)
+	if err != nil && errors.IsConflict(err) {
+		// somebody else got to it first
+		return
+	} else if err != nil {
+		logger.Error(err, "failed to claim build")
+		return
+	}
+
+	logger.Info("deploying build", "image", image)
+	s.deploying.Store(buildKey(run), true)
+	go func() {
+		defer s.deploying.Delete(buildKey(run))
+		s.deployBuild(ctx, run, image, logger)
+	}()
+}
+
+// claimBuild marks a build as deploying.
+// The update is conditional on the version of the pipeline run that was looked at,
+// if somebody else claimed (or cancelled) the build in the meantime, it fails with a conflict.
+func (s *CliServer) claimBuild(ctx context.Context, run *tekton.PipelineRun) (*tekton.PipelineRun, error) {
+	run = run.DeepCopy()
+	run.Labels[labelBuildPhase] = buildPhaseDeploying
+	setAnnotations(&run.ObjectMeta, map[string]string{
+		annotationDeployStartedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	return s.tektonClient.TektonV1beta1().PipelineRuns(run.Namespace).Update(ctx, run, metav1.UpdateOptions{})
+}
+
+// deployBuild deploys the image of a build as the service the build belongs to
+// and records the outcome on the build.
+// The deploy runs on behalf of whoever started the build.
+func (s *CliServer) deployBuild(ctx context.Context, run *tekton.PipelineRun, image string, logger logr.Logger) {
+	ctx, cancel := context.WithTimeout(ctx, buildDeployTimeout)
+	defer cancel()
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(callerMetadataKey, run.Annotations[annotationCreatedBy]))
+
+	// the build might have been cancelled since it was claimed, e.g. because its service was deleted
+	current, err := s.tektonClient.TektonV1beta1().PipelineRuns(run.Namespace).Get(ctx, run.Name, metav1.GetOptions{})
+	if err != nil {
+		logger.Error(err, "failed to get build")
+		return
+	} else if current.Labels[labelBuildPhase] != buildPhaseDeploying || current.Annotations[annotationDeployStartedAt] != run.Annotations[annotationDeployStartedAt] {
+		logger.Info("build isn't ours to deploy anymore", "phase", current.Labels[labelBuildPhase])
+		return
+	}
+
+	service, revision, err := s.deploy(ctx, run.Namespace, &pb.DeployRequest{
+		ServiceName: run.Labels[labelService],
+		Image:       image,
+	})
+	if err != nil {
+		logger.Error(err, "failed to deploy build")
+		s.finishBuild(ctx, run, buildPhaseFailed, map[string]string{annotationBuildMessage: err.Error()}, logger)
+		return
+	}
+
+	ready, err := s.waitForService(ctx, service, logger)
+	if err != nil {
+		logger.Error(err, "failed to watch service")
+		s.finishBuild(ctx, run, buildPhaseFailed, map[string]string{annotationBuildMessage: err.Error()}, logger)
+		return
+	}
+
+	logger.Info("build deployed", "url", ready.Status.URL)
+	s.finishBuild(ctx, run, buildPhaseDeployed, map[string]string{
+		annotationBuildURL:      ready.Status.URL,
+		annotationBuildRevision: strconv.FormatInt(revision, 10),
+	}, logger)
+}
+
+// finishBuild puts a build into its final phase.
+// Nothing happens if the build moved on in the meantime, e.g. because somebody else took over its deploy.
+func (s *CliServer) finishBuild(ctx context.Context, run *tekton.PipelineRun, phase string, annotations map[string]string, logger logr.Logger) {
+	// the outcome is recorded even if the deploy ran out of time
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, buildFinishTimeout)
+	defer cancel()
+
+	runs := s.tektonClient.TektonV1beta1().PipelineRuns(run.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := runs.Get(ctx, run.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		} else if current.Labels[labelBuildPhase] != run.Labels[labelBuildPhase] || current.Annotations[annotationDeployStartedAt] != run.Annotations[annotationDeployStartedAt] {
+			return nil
+		}
+
+		current.Labels[labelBuildPhase] = phase
+		setAnnotations(&current.ObjectMeta, annotations)
+		_, err = runs.Update(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "failed to record build outcome", "phase", phase)
+	}
+}
+
+// serviceDeleted tells whether the service a build was started for was deleted since.
+// Builds of new services have nothing to compare against, deleting a service cancels those (see cancelServiceBuilds).
+func (s *CliServer) serviceDeleted(ctx context.Context, run *tekton.PipelineRun) (bool, error) {
+	uid := run.Annotations[annotationServiceUID]
+	if uid == "" {
+		return false, nil
+	}
+
+	service, err := s.haikuClient.ServingV1alpha1().Services(run.Namespace).Get(ctx, run.Labels[labelService], metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return string(service.UID) != uid, nil
+}
+
+func serviceDeletedMessage(run *tekton.PipelineRun) string {
+	return fmt.Sprintf("service %s was deleted while build %s was going on", run.Labels[labelService], run.Name)
+}
+
+// deployOverdue tells whether the deploy of a build should have finished by now.
+func deployOverdue(run *tekton.PipelineRun) bool {
+	startedAt, err := time.Parse(time.RFC3339, run.Annotations[annotationDeployStartedAt])
+	return err != nil || time.Since(startedAt) > buildDeployTimeout
+}
+
+func buildKey(run *tekton.PipelineRun) string {
+	return run.Namespace + "/" + run.Name
+}
@@ -0,0 +1,332 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	"github.com/go-logr/logr"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	tekton "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// tekton prefixes the containers of steps with this
+	stepContainerPrefix = "step-"
+	// k8s doesn't tell stdout and stderr of a container apart
+	logStreamCombined = "combined"
+	logContentType    = "text/plain"
+
+	stepPollInterval = time.Second
+	maxLogLineBytes  = 1 << 20
+	// how long storing the log of a finished build may take
+	storeBuildLogTimeout = 2 * time.Minute
+)
+
+// buildLog fans the output of the steps of a build out to whoever follows the build.
+// Updates are sent from multiple goroutines, buildLog serializes them.
+// The log that's kept in object storage is written by the build reconciler, not here.
+type buildLog struct {
+	s         *CliServer
+	namespace string
+	buildID   string
+	logger    logr.Logger
+
+	mu       sync.Mutex
+	sendFunc func(*pb.DeploymentUpdate) error
+
+	wg     sync.WaitGroup
+	tailed map[string]bool
+}
+
+func (s *CliServer) newBuildLog(run *tekton.PipelineRun, send func(*pb.DeploymentUpdate) error, logger logr.Logger) *buildLog {
+	return &buildLog{
+		s:         s,
+		namespace: run.Namespace,
+		buildID:   run.Name,
+		logger:    logger,
+		sendFunc:  send,
+		tailed:    map[string]bool{},
+	}
+}
+
+func (l *buildLog) send(update *pb.DeploymentUpdate) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.sendFunc(update)
+}
+
+// follow tails the steps of all task runs that have a pod by now.
+// Every pod is tailed once.
+func (l *buildLog) follow(ctx context.Context, run *tekton.PipelineRun) {
+	for _, taskRun := range run.Status.TaskRuns {
+		if taskRun.Status == nil || taskRun.Status.PodName == "" || l.tailed[taskRun.Status.PodName] {
+			continue
+		}
+		l.tailed[taskRun.Status.PodName] = true
+
+		l.wg.Add(1)
+		go func(stage string, podName string) {
+			defer l.wg.Done()
+			err := l.s.readStepLogs(ctx, l.namespace, stage, podName, true, func(update *pb.DeploymentUpdate) error {
+				update.BuildID = l.buildID
+				return l.send(update)
+			})
+			if err != nil && ctx.Err() == nil {
+				l.logger.Error(err, "failed to tail build logs", "podName", podName)
+			}
+		}(taskRun.PipelineTaskName, taskRun.Status.PodName)
+	}
+}
+
+// close waits for all steps to finish logging.
+func (l *buildLog) close() {
+	l.wg.Wait()
+}
+
+// storeBuildLog copies the output of all steps of a finished build into object storage,
+// GetBuildLogs reads it from there once the task pods are gone.
+// It doesn't depend on anybody following the build, the build reconciler calls it.
+// The object only comes into existence once everything is written and flushed, a failure drops it.
+func (s *CliServer) storeBuildLog(ctx context.Context, run *tekton.PipelineRun, logger logr.Logger) {
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, storeBuildLogTimeout)
+	defer cancel()
+
+	namespace, err := s.k8sClient.CoreV1().Namespaces().Get(ctx, run.Namespace, metav1.GetOptions{})
+	if err != nil {
+		logger.Error(err, "failed to get namespace of build")
+		return
+	}
+
+	key := buildLogKey(namespace.Labels[labelEnvironment], run.Labels[labelService], run.Name)
+	writer := s.gcsClient.Bucket(s.uploadBucket).Object(key).NewWriter(ctx)
+	writer.ContentType = logContentType
+	writer.Metadata = map[string]string{
+		objectMetadataRequester: run.Annotations[annotationCreatedBy],
+		objectMetadataRequestID: run.Annotations[annotationRequestID],
+	}
+
+	err = s.readBuildLog(ctx, run, func(update *pb.DeploymentUpdate) error {
+		_, err := io.WriteString(writer, formatLogLine(update))
+		return err
+	})
+	if err != nil {
+		// cancelling the context before the writer is closed drops whatever was written so far
+		cancel()
+		logger.Error(err, "failed to read build log")
+		return
+	}
+
+	err = writer.Close()
+	if err != nil {
+		logger.Error(err, "failed to store build log", "key", key)
+	}
+}
+
+// readBuildLog reads the output of all steps of a build, stage by stage.
+func (s *CliServer) readBuildLog(ctx context.Context, run *tekton.PipelineRun, fn func(*pb.DeploymentUpdate) error) error {
+	for _, stage := range buildStages {
+		for _, taskRun := range run.Status.TaskRuns {
+			if taskRun.PipelineTaskName != stage || taskRun.Status == nil || taskRun.Status.PodName == "" {
+				continue
+			}
+			err := s.readStepLogs(ctx, run.Namespace, stage, taskRun.Status.PodName, false, fn)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readStepLogs reads the logs of all steps of a task pod in order.
+// With follow, it waits for steps to start and for them to finish logging.
+func (s *CliServer) readStepLogs(ctx context.Context, namespaceName string, stage string, podName string, follow bool, fn func(*pb.DeploymentUpdate) error) error {
+	pod, err := s.k8sClient.CoreV1().Pods(namespaceName).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if !strings.HasPrefix(container.Name, stepContainerPrefix) {
+			continue
+		}
+
+		if follow {
+			started, err := s.waitForContainer(ctx, namespaceName, podName, container.Name)
+			if err != nil {
+				return err
+			} else if !started {
+				// the pod is done without the step ever running
+				continue
+			}
+		} else if !containerStarted(pod, container.Name) {
+			continue
+		}
+
+		step := strings.TrimPrefix(container.Name, stepContainerPrefix)
+		err = s.readContainerLogs(ctx, namespaceName, podName, container.Name, follow, func(timestamp time.Time, line string) error {
+			return fn(&pb.DeploymentUpdate{
+				Message:   line,
+				Stage:     stage,
+				Status:    pb.DeploymentUpdate_LOG,
+				Timestamp: timestamp.Unix(),
+				Step:      step,
+				Stream:    logStreamCombined,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForContainer tells whether a container started before its pod finished.
+func (s *CliServer) waitForContainer(ctx context.Context, namespaceName string, podName string, containerName string) (bool, error) {
+	ticker := time.NewTicker(stepPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pod, err := s.k8sClient.CoreV1().Pods(namespaceName).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		} else if containerStarted(pod, containerName) {
+			return true, nil
+		} else if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			return false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func containerStarted(pod *corev1.Pod, containerName string) bool {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Name == containerName {
+			return containerStatus.State.Running != nil || containerStatus.State.Terminated != nil
+		}
+	}
+	return false
+}
+
+func (s *CliServer) readContainerLogs(ctx context.Context, namespaceName string, podName string, containerName string, follow bool, fn func(time.Time, string) error) error {
+	logs, err := s.k8sClient.CoreV1().Pods(namespaceName).GetLogs(podName, &corev1.PodLogOptions{
+		Container:  containerName,
+		Follow:     follow,
+		Timestamps: true,
+	}).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxLogLineBytes)
+	for scanner.Scan() {
+		err = fn(splitLogTimestamp(scanner.Text()))
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// splitLogTimestamp splits off the timestamp k8s puts in front of every log line.
+func splitLogTimestamp(line string) (time.Time, string) {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) == 2 {
+		timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+		if err == nil {
+			return timestamp, parts[1]
+		}
+	}
+	return time.Now(), line
+}
+
+func formatLogLine(update *pb.DeploymentUpdate) string {
+	return fmt.Sprintf("%s %s/%s %s\n", time.Unix(update.Timestamp, 0).UTC().Format(time.RFC3339), update.Stage, update.Step, update.Message)
+}
+
+// buildLogKey puts the log of a build next to the archives of the service.
+func buildLogKey(environmentName string, serviceName string, buildID string) string {
+	return getUploadKeyPrefix(environmentName, serviceName) + "logs/" + buildID + ".log"
+}
+
+// GetBuildLogs returns the complete output of a build.
+// Builds that are still running, or whose log didn't make it into object storage,
+// are read from the task pods as long as they are around.
+func (s *CliServer) GetBuildLogs(ctx context.Context, req *pb.GetBuildLogsRequest) (*pb.GetBuildLogsReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "buildID", req.BuildID, "requestID", requestid.FromContext(ctx))
+	logger.Info("get build logs")
+
+	run, err := s.getBuild(ctx, namespaceName, req.BuildID)
+	if err != nil && errors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: build %s", ErrNotFound, req.BuildID)
+	} else if err != nil {
+		logger.Error(err, "failed to get build")
+		return nil, err
+	}
+
+	reader, err := s.gcsClient.Bucket(s.uploadBucket).Object(buildLogKey(req.EnvironmentName, run.Labels[labelService], run.Name)).NewReader(ctx)
+	if err == nil {
+		defer reader.Close()
+		log, err := io.ReadAll(reader)
+		if err != nil {
+			logger.Error(err, "failed to read build log")
+			return nil, err
+		}
+		return &pb.GetBuildLogsReply{
+			Log: string(log),
+		}, nil
+	} else if !goerrors.Is(err, storage.ErrObjectNotExist) {
+		logger.Error(err, "failed to open build log")
+		return nil, err
+	}
+
+	var log strings.Builder
+	err = s.readBuildLog(ctx, run, func(update *pb.DeploymentUpdate) error {
+		log.WriteString(formatLogLine(update))
+		return nil
+	})
+	if err != nil && errors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: the logs of build %s are gone", ErrNotFound, run.Name)
+	} else if err != nil {
+		logger.Error(err, "failed to read step logs")
+		return nil, err
+	}
+
+	return &pb.GetBuildLogsReply{
+		Log: log.String(),
+	}, nil
+}
+
+// getBuild returns the pipeline run behind a build.
+// Pipeline runs haiku-api didn't start are treated as not found.
+func (s *CliServer) getBuild(ctx context.Context, namespaceName string, buildID string) (*tekton.PipelineRun, error) {
+	run, err := s.tektonClient.TektonV1beta1().PipelineRuns(namespaceName).Get(ctx, buildID, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	} else if run.Labels[labelManagedBy] != managedByHaikuAPI || run.Labels[labelComponent] != componentBuild {
+		return nil, errors.NewNotFound(tekton.Resource("pipelineruns"), buildID)
+	}
+	return run, nil
+}
@@ -0,0 +1,256 @@
+package v1
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	tekton "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/util/retry"
+	"knative.dev/pkg/apis"
+)
+
+// errBuildCancelled ends following a build that was cancelled.
+// It's not a failure, whoever follows the build is told about it in an update.
+var errBuildCancelled = goerrors.New("build cancelled")
+
+// ListBuilds returns the builds of an environment (or one of its services), newest first.
+func (s *CliServer) ListBuilds(ctx context.Context, req *pb.ListBuildsRequest) (*pb.ListBuildsReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("list builds")
+	selector := map[string]string{
+		labelManagedBy: managedByHaikuAPI,
+		labelComponent: componentBuild,
+	}
+	if req.ServiceName != "" {
+		selector[labelService] = req.ServiceName
+	}
+	runs, err := s.tektonClient.TektonV1beta1().PipelineRuns(namespaceName).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector).String(),
+	})
+	if err != nil {
+		logger.Error(err, "failed to list pipeline runs")
+		return nil, err
+	}
+
+	sort.Slice(runs.Items, func(i, j int) bool {
+		return runs.Items[j].CreationTimestamp.Before(&runs.Items[i].CreationTimestamp)
+	})
+	builds := make([]*pb.Build, len(runs.Items))
+	for idx := range runs.Items {
+		builds[idx] = toPbBuild(&runs.Items[idx])
+	}
+
+	return &pb.ListBuildsReply{
+		Builds: builds,
+	}, nil
+}
+
+func (s *CliServer) GetBuild(ctx context.Context, req *pb.GetBuildRequest) (*pb.GetBuildReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "buildID", req.BuildID, "requestID", requestid.FromContext(ctx))
+	logger.Info("get build")
+	run, err := s.getBuild(ctx, namespaceName, req.BuildID)
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("build doesn't exist")
+		return nil, fmt.Errorf("%w: build %s", ErrNotFound, req.BuildID)
+	} else if err != nil {
+		logger.Error(err, "failed to get build")
+		return nil, err
+	}
+
+	return &pb.GetBuildReply{
+		Build: toPbBuild(run),
+	}, nil
+}
+
+// CancelBuild stops a build that is still pending or running, or whose image waits to be deployed.
+// Cancelling a cancelled build is fine, cancelling a finished one is not.
+// Neither is cancelling a build that is deploying or deployed, that's what rolling back is for.
+// Whoever follows the build is told it was cancelled.
+func (s *CliServer) CancelBuild(ctx context.Context, req *pb.CancelBuildRequest) (*pb.CancelBuildReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "buildID", req.BuildID, "requestID", requestid.FromContext(ctx))
+	logger.Info("cancel build")
+
+	var run *tekton.PipelineRun
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		run, err = s.getBuild(ctx, namespaceName, req.BuildID)
+		if err != nil {
+			return err
+		}
+
+		phase := run.Labels[labelBuildPhase]
+		switch {
+		case run.IsCancelled() || phase == buildPhaseCancelled:
+			return nil
+		case phase == buildPhaseDeploying || phase == buildPhaseDeployed:
+			return status.Errorf(codes.FailedPrecondition, "build %s is deployed already, roll back the service instead", run.Name)
+		case run.IsDone() && (phase != buildPhaseBuilding || !run.Status.GetCondition(apis.ConditionSucceeded).IsTrue()):
+			return status.Errorf(codes.FailedPrecondition, "build %s already finished", run.Name)
+		case run.IsDone():
+			// the image is pushed but not deployed yet, the build reconciler leaves cancelled builds alone
+			// if it claimed the build in the meantime, the update conflicts and the next try sees it deploying
+			run.Labels[labelBuildPhase] = buildPhaseCancelled
+		default:
+			run.Spec.Status = tekton.PipelineRunSpecStatusCancelled
+		}
+		if run.Annotations == nil {
+			run.Annotations = map[string]string{}
+		}
+		run.Annotations[annotationCancelledBy] = callerFromContext(ctx)
+		run, err = s.tektonClient.TektonV1beta1().PipelineRuns(namespaceName).Update(ctx, run, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("build doesn't exist")
+		return nil, fmt.Errorf("%w: build %s", ErrNotFound, req.BuildID)
+	} else if err != nil {
+		logger.Error(err, "failed to cancel build")
+		return nil, err
+	}
+
+	return &pb.CancelBuildReply{
+		Build: toPbBuild(run),
+	}, nil
+}
+
+// cancelServiceBuilds cancels the builds of a service that are still going on, the service is about to be deleted.
+// Unlike CancelBuild, it cancels builds that are being deployed too, their deploy doesn't go through (see deployBuild).
+func (s *CliServer) cancelServiceBuilds(ctx context.Context, namespaceName string, serviceName string, logger logr.Logger) error {
+	pending, err := labels.NewRequirement(labelBuildPhase, selection.In, []string{buildPhaseBuilding, buildPhaseDeploying})
+	if err != nil {
+		return err
+	}
+
+	selector := labels.SelectorFromSet(serviceLabels(serviceName, componentBuild)).Add(*pending)
+	runs := s.tektonClient.TektonV1beta1().PipelineRuns(namespaceName)
+	list, err := runs.List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		logger.Error(err, "failed to list builds")
+		return err
+	}
+
+	for idx := range list.Items {
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			run, err := runs.Get(ctx, list.Items[idx].Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			phase := run.Labels[labelBuildPhase]
+			switch {
+			case phase != buildPhaseBuilding && phase != buildPhaseDeploying:
+				return nil
+			case phase == buildPhaseBuilding && !run.IsDone():
+				// the build reconciler records the cancellation once the pipeline stopped
+				run.Spec.Status = tekton.PipelineRunSpecStatusCancelled
+			default:
+				// the claim of a deploy goes away with the phase
+				run.Labels[labelBuildPhase] = buildPhaseCancelled
+				setAnnotations(&run.ObjectMeta, map[string]string{
+					annotationBuildMessage: serviceDeletedMessage(run),
+				})
+			}
+			setAnnotations(&run.ObjectMeta, map[string]string{
+				annotationCancelledBy: callerFromContext(ctx),
+			})
+			_, err = runs.Update(ctx, run, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "failed to cancel build", "buildID", list.Items[idx].Name)
+			return err
+		}
+	}
+	return nil
+}
+
+func toPbBuild(run *tekton.PipelineRun) *pb.Build {
+	build := &pb.Build{
+		ID:          run.Name,
+		ServiceName: run.Labels[labelService],
+		SourceKey:   run.Annotations[annotationSourceKey],
+		RequestedBy: run.Annotations[annotationCreatedBy],
+		RequestID:   run.Annotations[annotationRequestID],
+		Status:      buildStatus(run),
+	}
+	if run.Status.StartTime != nil {
+		build.StartedAt = run.Status.StartTime.Unix()
+	}
+	if run.Status.CompletionTime != nil {
+		build.FinishedAt = run.Status.CompletionTime.Unix()
+	}
+	if digest := buildImageDigest(run); digest != "" {
+		build.Image = run.Annotations[annotationBuildRepository] + "@" + digest
+	}
+	if build.Status == pb.Build_DEPLOYED {
+		build.URL = run.Annotations[annotationBuildURL]
+		build.Revision, _ = strconv.ParseInt(run.Annotations[annotationBuildRevision], 10, 64)
+	}
+	condition := run.Status.GetCondition(apis.ConditionSucceeded)
+	if message := run.Annotations[annotationBuildMessage]; build.Status == pb.Build_FAILED && message != "" {
+		build.Message = message
+	} else if build.Status == pb.Build_FAILED && condition != nil {
+		build.Message = condition.Message
+	}
+	return build
+}
+
+// buildStatus tells where a build is at.
+// Once the pipeline succeeded, the phase the build reconciler keeps track of takes over.
+func buildStatus(run *tekton.PipelineRun) pb.Build_BuildStatus {
+	switch run.Labels[labelBuildPhase] {
+	case buildPhaseDeploying:
+		return pb.Build_DEPLOYING
+	case buildPhaseDeployed:
+		return pb.Build_DEPLOYED
+	case buildPhaseFailed:
+		return pb.Build_FAILED
+	case buildPhaseCancelled:
+		return pb.Build_CANCELLED
+	}
+
+	condition := run.Status.GetCondition(apis.ConditionSucceeded)
+	switch {
+	case condition == nil || run.Status.StartTime == nil:
+		return pb.Build_PENDING
+	case condition.IsTrue():
+		return pb.Build_SUCCEEDED
+	case condition.IsFalse() && isBuildCancelled(run):
+		return pb.Build_CANCELLED
+	case condition.IsFalse():
+		return pb.Build_FAILED
+	}
+	return pb.Build_RUNNING
+}
+
+// isBuildCancelled tells whether a pipeline run ended because it was cancelled.
+func isBuildCancelled(run *tekton.PipelineRun) bool {
+	condition := run.Status.GetCondition(apis.ConditionSucceeded)
+	return condition.IsFalse() && (run.IsCancelled() || condition.Reason == tekton.PipelineRunReasonCancelled.String())
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	callerMetadataKey = "x-haiku-user"
+	unknownCaller     = "unknown"
+)
+
+// callerFromContext returns who is making the request.
+// There's no authentication yet, so we trust the user the CLI sends along
+// and fall back to the address the request came from.
+func callerFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		header := md.Get(callerMetadataKey)
+		if len(header) > 0 && header[0] != "" {
+			return header[0]
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+
+	return unknownCaller
+}
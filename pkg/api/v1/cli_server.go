@@ -4,10 +4,10 @@ import (
 	"context"
 	goerrors "errors"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	storage "cloud.google.com/go/storage"
@@ -18,17 +18,23 @@ import (
 	ho "github.com/mhelmich/haiku-operator/apis/entities/v1alpha1"
 	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
 	hc "github.com/mhelmich/haiku-operator/clientset"
-	tekton "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	tc "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/util/retry"
 )
 
 func KubeConfigGetter(path string) clientcmd.KubeconfigGetter {
@@ -64,35 +70,85 @@ func NewCliServer(configPath string, logger logr.Logger) (*CliServer, error) {
 		return nil, err
 	}
 
+	tektonClient, err := tc.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	gcsClient, err := getGcsClient(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
 	return &CliServer{
-		k8sClient:   k8sClient,
-		haikuClient: haikuClient,
-		gcsClient:   gcsClient,
-		logger:      logger,
+		k8sClient:    k8sClient,
+		haikuClient:  haikuClient,
+		tektonClient: tektonClient,
+		gcsClient:    gcsClient,
+		uploadBucket: uploadBucketFromEnv(),
+		logger:       logger,
 	}, nil
 }
 
 type CliServer struct {
 	pb.UnimplementedCliServiceServer
 
-	k8sClient   *kubernetes.Clientset
-	haikuClient *hc.Clientset
-	gcsClient   *storage.Client
-	logger      logr.Logger
+	k8sClient    *kubernetes.Clientset
+	haikuClient  *hc.Clientset
+	tektonClient *tc.Clientset
+	gcsClient    *storage.Client
+	uploadBucket string
+	logger       logr.Logger
+
+	// the builds this server is deploying right now, by namespace and name
+	deploying sync.Map
 }
 
 // This will have to create a k8s namespace and likely more stuff.
+// With a tier, the namespace gets a quota, container limits and a network policy right away.
+// If those can't be applied, the namespace is deleted again, Init can be retried once it's gone.
+// Environments with a ttl or expiry are deleted by the reaper once they expire.
 func (s *CliServer) Init(ctx context.Context, req *pb.InitRequest) (*pb.InitReply, error) {
-	logger := s.logger.WithValues("namespaceName", req.EnvironmentName, "requestID", requestid.FromContext(ctx))
-	logger.Info("init namespace")
+	namespaceName, err := getK8sNamespaceForHaikuSpaceName(req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("init namespace", "tier", req.Tier)
+	namespaceLabels := environmentLabels(req.EnvironmentName)
+	if req.Tier != "" {
+		if _, ok := tiers[req.Tier]; !ok {
+			return nil, fmt.Errorf("%w: unknown tier %s", ErrInvalidArgument, req.Tier)
+		}
+		namespaceLabels[labelTier] = req.Tier
+	}
+
+	namespaceAnnotations := map[string]string{
+		annotationCreatedBy: callerFromContext(ctx),
+		annotationRequestID: requestid.FromContext(ctx),
+	}
+	expiresAt, err := expiryFromRequest(req)
+	if err != nil {
+		return nil, err
+	} else if !expiresAt.IsZero() {
+		namespaceAnnotations[annotationExpiresAt] = formatExpiry(expiresAt)
+	}
+
+	// environments adopted from before the prefix live in a namespace of another name
+	_, err = s.getEnvironment(ctx, req.EnvironmentName)
+	if err == nil {
+		logger.Info("environment already exists")
+		return nil, ErrAlreadyExists
+	} else if !IsNotFound(err) {
+		logger.Error(err, "failed to get environment")
+		return nil, err
+	}
+
 	k8sNamespace, err := s.k8sClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: req.EnvironmentName,
+			Name:        namespaceName,
+			Labels:      namespaceLabels,
+			Annotations: namespaceAnnotations,
 		},
 	}, metav1.CreateOptions{})
 	if err != nil && errors.IsAlreadyExists(err) {
@@ -103,92 +159,321 @@ func (s *CliServer) Init(ctx context.Context, req *pb.InitRequest) (*pb.InitRepl
 		return nil, err
 	}
 
+	if req.Tier != "" {
+		err = s.applyGuardrails(ctx, namespaceName, req.Tier)
+		if err != nil {
+			logger.Error(err, "failed to apply guardrails")
+			s.deleteUnguardedEnvironment(ctx, k8sNamespace, logger)
+			return nil, err
+		}
+	}
+
 	return &pb.InitReply{
 		ID: string(k8sNamespace.UID),
 	}, nil
 }
 
-// This will have to create or update a haiku service manifest.
+// deleteUnguardedEnvironment takes back an environment that was created without its guardrails.
+// An environment without them must not be used, and Init can only be retried once it's gone.
+// It runs on a context of its own, the request might be over already.
+func (s *CliServer) deleteUnguardedEnvironment(ctx context.Context, namespace *corev1.Namespace, logger logr.Logger) {
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, guardrailCleanupTimeout)
+	defer cancel()
+	err := s.k8sClient.CoreV1().Namespaces().Delete(ctx, namespace.Name, metav1.DeleteOptions{
+		Preconditions: metav1.NewUIDPreconditions(string(namespace.UID)),
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "failed to delete environment without guardrails")
+	}
+}
+
+// This creates or updates a haiku service manifest.
 // Those are relatively simple and be pulled in from the haiku operator.
 // The main attribute of those is the image url.
+// Every deploy results in a new revision of the service.
 func (s *CliServer) Deploy(ctx context.Context, req *pb.DeployRequest) (*pb.DeployReply, error) {
-	logger := s.logger.WithValues("namespaceName", req.EnvironmentName, "requestID", requestid.FromContext(ctx))
-	logger.Info("deploy namespace")
-	service, err := s.haikuClient.ServingV1alpha1().Services(req.EnvironmentName).Create(ctx, &v1alpha1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: req.EnvironmentName,
-			Name:      req.ServiceName,
-		},
-		Spec: v1alpha1.ServiceSpec{
-			Image: req.Image,
-		},
-	}, metav1.CreateOptions{})
-	if err != nil && errors.IsAlreadyExists(err) {
-		logger.Info("service already exists")
-		return nil, ErrAlreadyExists
-	} else if err != nil {
-		logger.Error(err, "failed to create service")
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
 		return nil, err
 	}
-
-	watcher, err := s.haikuClient.ServingV1alpha1().Services(req.EnvironmentName).Watch(ctx, metav1.ListOptions{})
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("deploy service", "image", req.Image)
+	service, revision, err := s.deploy(ctx, namespaceName, req)
 	if err != nil {
-		logger.Error(err, "failed to create watcher for service")
+		logger.Error(err, "failed to deploy service")
 		return nil, err
 	}
 
-	serviceURL, err := waitForServiceURLUpdated(ctx, watcher, logger)
+	service, err = s.waitForService(ctx, service, logger)
 	if err != nil {
 		logger.Error(err, "failed to watch service")
 		return nil, err
 	}
 
 	return &pb.DeployReply{
-		URL: serviceURL,
-		ID:  string(service.UID),
+		URL:      service.Status.URL,
+		ID:       string(service.UID),
+		Revision: revision,
+		Traffic:  toPbTraffic(service),
 	}, nil
 }
 
-func waitForServiceURLUpdated(ctx context.Context, watcher watch.Interface, logger logr.Logger) (string, error) {
+// deploy applies the image, settings and traffic of a deploy request to the haiku service.
+func (s *CliServer) deploy(ctx context.Context, namespaceName string, req *pb.DeployRequest) (*v1alpha1.Service, int64, error) {
+	err := s.validateSettings(ctx, namespaceName, req.Settings)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	traffic, err := s.resolveTraffic(ctx, namespaceName, req.ServiceName, req.Traffic)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	service, revision, err := s.applyService(ctx, namespaceName, req.ServiceName, func(svc *v1alpha1.Service) {
+		svc.Spec.Image = req.Image
+		if req.Settings != nil {
+			setSettings(svc, req.Settings)
+		}
+		setTraffic(svc, traffic)
+	})
+	return service, revision, err
+}
+
+// applyService creates the haiku service or updates it if it exists already.
+// mutate gets to set the spec, the env and deploy annotations are taken care of here.
+// Every call is recorded as revision, its number is returned alongside the service.
+// The revision of the haiku service is named after it, so every call cuts a new one
+// even if the spec stayed the same (think redeploying a mutable image tag).
+func (s *CliServer) applyService(ctx context.Context, namespaceName string, serviceName string, mutate func(*v1alpha1.Service)) (*v1alpha1.Service, int64, error) {
+	envFrom, envRevision, err := s.pinEnv(ctx, namespaceName, serviceName)
+	if err != nil {
+		return nil, 0, err
+	}
+	revision, err := s.reserveRevision(ctx, namespaceName, serviceName, envRevision)
+	if err != nil {
+		return nil, 0, err
+	}
+	annotations := map[string]string{
+		annotationDeployedBy: callerFromContext(ctx),
+		annotationDeployedAt: time.Now().UTC().Format(time.RFC3339Nano),
+		annotationRequestID:  requestid.FromContext(ctx),
+	}
+	apply := func(svc *v1alpha1.Service) {
+		setAnnotations(&svc.ObjectMeta, annotations)
+		mutate(svc)
+		svc.Spec.EnvFrom = envFrom
+		svc.Spec.RevisionName = knativeRevisionName(serviceName, revision)
+	}
+
+	services := s.haikuClient.ServingV1alpha1().Services(namespaceName)
+	var service *v1alpha1.Service
+	// if somebody else created the service in the meantime, start over and update it instead
+	err = retry.OnError(retry.DefaultRetry, isConflictOrAlreadyExists, func() error {
+		svc, err := services.Get(ctx, serviceName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			svc = &v1alpha1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   namespaceName,
+					Name:        serviceName,
+					Annotations: map[string]string{},
+				},
+			}
+			apply(svc)
+			service, err = services.Create(ctx, svc, metav1.CreateOptions{})
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		apply(svc)
+		service, err = services.Update(ctx, svc, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		// nothing was deployed, the revision number is up for grabs again
+		if releaseErr := s.releaseRevision(ctx, namespaceName, serviceName, revision); releaseErr != nil {
+			s.logger.Error(releaseErr, "failed to release revision", "namespaceName", namespaceName, "serviceName", serviceName, "revision", revision)
+		}
+		return nil, 0, err
+	}
+
+	err = s.recordRevision(ctx, service, revision)
+	if err != nil {
+		return service, revision, err
+	}
+
+	s.pruneRevisions(ctx, namespaceName, serviceName, revision)
+
+	err = s.pruneEnvCopies(ctx, namespaceName, serviceName)
+	if err != nil {
+		// leftover copies are harmless, the next deploy tries again
+		s.logger.Error(err, "failed to prune env copies", "namespaceName", namespaceName, "serviceName", serviceName)
+	}
+	return service, revision, nil
+}
+
+// updateService changes an existing haiku service without cutting a new revision.
+func (s *CliServer) updateService(ctx context.Context, namespaceName string, serviceName string, mutate func(*v1alpha1.Service)) (*v1alpha1.Service, error) {
+	services := s.haikuClient.ServingV1alpha1().Services(namespaceName)
+	var service *v1alpha1.Service
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		svc, err := services.Get(ctx, serviceName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			return ErrNotFound
+		} else if err != nil {
+			return err
+		}
+
+		mutate(svc)
+		service, err = services.Update(ctx, svc, metav1.UpdateOptions{})
+		return err
+	})
+	return service, err
+}
+
+// waitForService blocks until the revision the given version of the service was written with is serving.
+// In the meantime the pods of the revision are checked for failures that won't go away by waiting.
+// It returns the service as the operator reported it ready, URLs included.
+func (s *CliServer) waitForService(ctx context.Context, service *v1alpha1.Service, logger logr.Logger) (*v1alpha1.Service, error) {
+	watcher, err := s.haikuClient.ServingV1alpha1().Services(service.Namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", service.Name).String(),
+		ResourceVersion: service.ResourceVersion,
+	})
+	if err != nil {
+		logger.Error(err, "failed to create watcher for service")
+		return nil, err
+	}
+
+	diagnose := func(ctx context.Context) error {
+		failure, err := s.diagnoseRevision(ctx, service.Namespace, service.Spec.RevisionName)
+		if err != nil {
+			// diagnosing is best effort
+			logger.Error(err, "failed to diagnose revision")
+			return nil
+		} else if failure != nil {
+			return s.podFailureError(ctx, service.Namespace, failure)
+		}
+		return nil
+	}
+
+	return waitForRevisionReady(ctx, watcher, service, diagnose, logger)
+}
+
+func waitForRevisionReady(ctx context.Context, watcher watch.Interface, service *v1alpha1.Service, diagnose func(context.Context) error, logger logr.Logger) (*v1alpha1.Service, error) {
 	// it's safe be called multiple times
 	defer watcher.Stop()
+	ticker := time.NewTicker(diagnoseInterval)
+	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			// request timed out
-			return "", fmt.Errorf("timeout")
-		case event := <-watcher.ResultChan():
+			// request timed out, the pods are looked at one last time to tell why
+			diagnoseCtx, cancel := context.WithTimeout(detachedContext{ctx}, diagnoseTimeout)
+			defer cancel()
+			return nil, timeoutError(diagnose(diagnoseCtx))
+		case <-ticker.C:
+			err := diagnose(ctx)
+			if err != nil {
+				return nil, err
+			}
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("watch closed")
+			}
 			svc, ok := event.Object.(*v1alpha1.Service)
 			if !ok {
 				logger.Error(fmt.Errorf("object was %T", event.Object), "couldn't cast event watcher object to service")
+				continue
 			}
-			if svc.Status.URL != "" {
-				return svc.Status.URL, nil
+			ready, err := revisionReady(svc, service)
+			if err != nil {
+				// the pods usually know better what went wrong
+				if podErr := diagnose(ctx); podErr != nil {
+					return nil, podErr
+				}
+				return nil, err
+			} else if ready {
+				return svc, nil
 			}
 		}
 	}
 }
 
-// The env family of endpoints maybe gets stored as a k8s configmap.
-// The config map needs to be created in a particular namespace and with particular labels.
-func (s *CliServer) ListEnv(ctx context.Context, req *pb.ListEnvRequest) (*pb.ListEnvReply, error) {
-	return nil, nil
+// timeoutError tells that a revision didn't get ready in time, along with what the pods had to say about it.
+func timeoutError(diagnosis error) error {
+	message := "timed out waiting for the service to become ready"
+	if diagnosis == nil {
+		return status.Error(codes.DeadlineExceeded, message)
+	}
+
+	// the details of the diagnosis (pod, logs) are kept
+	st := status.Convert(diagnosis).Proto()
+	st.Code = int32(codes.DeadlineExceeded)
+	st.Message = message + ": " + st.Message
+	return status.FromProto(st).Err()
+}
+
+// revisionReady tells whether the revision the written version of a service asked for is serving.
+// Only a status the operator reported for the written generation (or a later one) counts,
+// anything older describes the previous revision.
+// A revision that failed for good is reported as error.
+func revisionReady(svc *v1alpha1.Service, written *v1alpha1.Service) (bool, error) {
+	if svc.Status.ObservedGeneration < written.Generation {
+		return false, nil
+	}
+
+	ready := meta.FindStatusCondition(svc.Status.Conditions, conditionReady)
+	if ready == nil {
+		return false, nil
+	}
+	switch ready.Status {
+	case metav1.ConditionTrue:
+		return svc.Status.LatestReadyRevisionName == written.Spec.RevisionName, nil
+	case metav1.ConditionFalse:
+		return false, status.Errorf(codes.FailedPrecondition, "revision %s failed with %s: %s", written.Spec.RevisionName, ready.Reason, ready.Message)
+	}
+	return false, nil
+}
+
+// detachedContext keeps the values of a request (request id, caller) without its deadline and cancellation.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
 }
 
-func (s *CliServer) SetEnv(ctx context.Context, req *pb.SetEnvRequest) (*pb.SetEnvReply, error) {
-	return nil, nil
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func isConflictOrAlreadyExists(err error) bool {
+	return errors.IsConflict(err) || errors.IsAlreadyExists(err)
 }
 
-func (s *CliServer) RemoveEnv(ctx context.Context, req *pb.RemoveEnvRequest) (*pb.RemoveEnvReply, error) {
-	return nil, nil
+func setAnnotations(meta *metav1.ObjectMeta, annotations map[string]string) {
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		meta.Annotations[k] = v
+	}
 }
 
 // This will have to create a k8s secret (and maybe patch that secret to the local service account).
 // As illustrated here: https://knative.dev/docs/serving/deploying-from-private-registry/
 func (s *CliServer) DockerLogin(ctx context.Context, req *pb.DockerLoginRequest) (*pb.DockerLoginReply, error) {
-	// TODO: write a "getK8sNamespaceForHaikuSpaceName" function
-	namespaceName := req.EnvironmentName
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
 	secretName := fmt.Sprintf("docker-%s-%s", uuid.NewString(), req.Server)
 	logger := s.logger.WithValues("namespaceName", namespaceName, "requestID", requestid.FromContext(ctx))
 	logger.Info("creating dockerlogin")
@@ -204,7 +489,7 @@ func (s *CliServer) DockerLogin(ctx context.Context, req *pb.DockerLoginRequest)
 			Email:    req.Email,
 		},
 	}
-	dl, err := s.haikuClient.EntitiesV1alpha1().DockerLogins(namespaceName).Create(ctx, dl, metav1.CreateOptions{})
+	dl, err = s.haikuClient.EntitiesV1alpha1().DockerLogins(namespaceName).Create(ctx, dl, metav1.CreateOptions{})
 	if err != nil && errors.IsAlreadyExists(err) {
 		logger.Info("dockerlogin already exists")
 		return nil, ErrAlreadyExists
@@ -233,10 +518,24 @@ func (s *CliServer) Up(stream pb.CliService_UpServer) error {
 		return err
 	}
 
-	logger = s.logger.WithValues("namespaceName", md.EnvironmentName)
-	err = consumeStreamAndUploadToTektonWorkspace(stream)
+	// nothing is read, let alone stored, before it's clear where the archive belongs
+	namespaceName, err := s.environmentNamespace(stream.Context(), md.EnvironmentName)
+	if err != nil {
+		return err
+	} else if err := validateServiceName(md.ServiceName); err != nil {
+		return err
+	}
+
+	logger = logger.WithValues("namespaceName", namespaceName, "serviceName", md.ServiceName)
+	key, err := s.uploadArchive(stream, md, logger)
 	if err != nil {
 		logger.Error(err, "consuming and uploading file failed")
+		// the client might be gone already, nothing we can do about that
+		_ = stream.Send(&pb.UpResponse{
+			Data: &pb.UpResponse_UploadStatus{
+				UploadStatus: pb.UploadStatus_FAILED,
+			},
+		})
 		return err
 	}
 
@@ -250,32 +549,52 @@ func (s *CliServer) Up(stream pb.CliService_UpServer) error {
 		return err
 	}
 
-	// todo: kick off tekton pipeline
-	tr := &tekton.TaskRun{}
-	logger.Info("task run", "tr", tr)
-	// todo: keep feeding updates to the client
+	ctx := stream.Context()
+	run, err := s.startBuild(ctx, namespaceName, md.EnvironmentName, md.ServiceName, key, logger)
+	if err != nil {
+		logger.Error(err, "failed to start build")
+		return err
+	}
 
+	logger = logger.WithValues("buildID", run.Name)
+	logger.Info("build started", "key", key)
+	// the build is deployed whether or not the client sticks around to follow it
+	_, err = s.followBuild(ctx, run, func(update *pb.DeploymentUpdate) error {
+		return stream.Send(&pb.UpResponse{
+			Data: &pb.UpResponse_DeploymentUpdate{
+				DeploymentUpdate: update,
+			},
+		})
+	}, logger)
+	if err != nil && goerrors.Is(err, errBuildCancelled) {
+		// the client knows already
+		logger.Info("build cancelled")
+		return nil
+	} else if err != nil {
+		logger.Error(err, "failed to follow build")
+		return err
+	}
 	return nil
 }
 
-func consumeStreamAndUploadToTektonWorkspace(stream pb.CliService_UpServer) error {
-	for {
-		req, err := stream.Recv()
-		if err != nil {
-			if err == io.EOF {
-				return nil
-			}
-			return err
-		}
-
-		// todo: upload to tekton workspace
-		fmt.Printf("%d\n", req.GetData())
+// validateServiceName makes sure a service name can be used as name of k8s objects and in upload keys.
+func validateServiceName(serviceName string) error {
+	if errs := validation.IsDNS1123Label(serviceName); len(errs) > 0 {
+		return fmt.Errorf("%w: service name %q: %s", ErrInvalidArgument, serviceName, strings.Join(errs, ", "))
 	}
 	return nil
 }
 
 func (s *CliServer) GetServiceUploadUrl(ctx context.Context, req *pb.GetServiceUploadUrlRequest) (*pb.GetServiceUploadUrlResponse, error) {
-	bucket := s.gcsClient.Bucket("haiku_service_storage") // todo: make this an environment variable
+	// uploads are keyed by environment and service name, the environment has to exist though
+	_, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	} else if err := validateServiceName(req.ServiceName); err != nil {
+		return nil, err
+	}
+
+	bucket := s.gcsClient.Bucket(s.uploadBucket)
 
 	if bucket == nil {
 		return nil, goerrors.New("unable to find bucket")
@@ -309,12 +628,13 @@ func getGcsClient(ctx context.Context) (*storage.Client, error) {
 var UPLOAD_KEY_REPLACER *strings.Replacer = strings.NewReplacer("/", "", "#", "", "[", "", "]", "", "?", "", "*", "")
 
 func getUrlUploadKey(environmentName string, serviceName string) string {
-	sanitizedEnvironmentName := UPLOAD_KEY_REPLACER.Replace(environmentName)
-	sanitizedServiceName := UPLOAD_KEY_REPLACER.Replace(serviceName)
 	timestampUnix := strconv.FormatInt(time.Now().Unix(), 10)
-	return sanitizedEnvironmentName + "/" + sanitizedServiceName + "/" + timestampUnix + "_" + uuid.New().String() + ".zip"
+	return getUploadKeyPrefix(environmentName, serviceName) + timestampUnix + "_" + uuid.New().String() + ".zip"
 }
 
-func DeployUrl(ctx context.Context, req *pb.DeployUrlRequest) (*pb.DeployUrlReply, error) {
-	return nil, nil
+// getUploadKeyPrefix is what the keys of all archives of a service start with.
+func getUploadKeyPrefix(environmentName string, serviceName string) string {
+	sanitizedEnvironmentName := UPLOAD_KEY_REPLACER.Replace(environmentName)
+	sanitizedServiceName := UPLOAD_KEY_REPLACER.Replace(serviceName)
+	return sanitizedEnvironmentName + "/" + sanitizedServiceName + "/"
 }
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	ho "github.com/mhelmich/haiku-operator/apis/entities/v1alpha1"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloneEnvironment creates a new environment that looks like an existing one.
+// Registry logins, the env of every service and the services at their current image (and settings) are copied.
+// Excluding secrets leaves out the registry logins along with the secret env.
+// Revisions and traffic splits aren't, every clone of a service starts out with a single revision.
+// If anything goes wrong halfway, the new environment is left as is for inspection.
+func (s *CliServer) CloneEnvironment(ctx context.Context, req *pb.CloneEnvironmentRequest) (*pb.CloneEnvironmentReply, error) {
+	source, err := s.getEnvironment(ctx, req.SourceEnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	sourceNamespaceName := source.Name
+	namespaceName, err := getK8sNamespaceForHaikuSpaceName(req.TargetEnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("sourceNamespaceName", sourceNamespaceName, "namespaceName", namespaceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("clone environment", "excludeSecrets", req.ExcludeSecrets)
+
+	services, err := s.haikuClient.ServingV1alpha1().Services(sourceNamespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Error(err, "failed to list services")
+		return nil, err
+	}
+
+	// catch typos before anything is created
+	serviceNames := map[string]bool{}
+	for _, svc := range services.Items {
+		serviceNames[svc.Name] = true
+	}
+	for serviceName := range req.ImageOverrides {
+		if !serviceNames[serviceName] {
+			return nil, fmt.Errorf("%w: service %s doesn't exist in environment %s", ErrInvalidArgument, serviceName, req.SourceEnvironmentName)
+		}
+	}
+
+	// registry passwords are secrets as well, they stay behind with the secret env
+	dockerLogins := &ho.DockerLoginList{}
+	if !req.ExcludeSecrets {
+		dockerLogins, err = s.haikuClient.EntitiesV1alpha1().DockerLogins(sourceNamespaceName).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			logger.Error(err, "failed to list dockerlogins")
+			return nil, err
+		}
+	}
+
+	tier := req.Tier
+	if tier == "" {
+		tier = source.Labels[labelTier]
+	}
+	initReply, err := s.Init(ctx, &pb.InitRequest{
+		EnvironmentName: req.TargetEnvironmentName,
+		Tier:            tier,
+		TTLSeconds:      req.TTLSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// images need to be pullable before the first service is deployed
+	for _, dl := range dockerLogins.Items {
+		_, err = s.haikuClient.EntitiesV1alpha1().DockerLogins(namespaceName).Create(ctx, &ho.DockerLogin{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      dl.Name,
+			},
+			Spec: dl.Spec,
+		}, metav1.CreateOptions{})
+		if err != nil {
+			logger.Error(err, "failed to create dockerlogin", "dockerLogin", dl.Name)
+			return nil, err
+		}
+	}
+
+	applied := make([]*v1alpha1.Service, len(services.Items))
+	revisions := make([]int64, len(services.Items))
+	for idx := range services.Items {
+		svc := &services.Items[idx]
+		env, secretEnv, err := s.getEnv(ctx, sourceNamespaceName, svc.Name)
+		if err != nil {
+			logger.Error(err, "failed to get env", "serviceName", svc.Name)
+			return nil, err
+		}
+		if req.ExcludeSecrets {
+			secretEnv = map[string]string{}
+		}
+
+		if len(env) > 0 || len(secretEnv) > 0 {
+			_, err = s.replaceEnv(ctx, namespaceName, svc.Name, env, secretEnv)
+			if err != nil {
+				logger.Error(err, "failed to copy env", "serviceName", svc.Name)
+				return nil, err
+			}
+		}
+
+		spec := svc.Spec
+		if image, ok := req.ImageOverrides[svc.Name]; ok {
+			spec.Image = image
+		}
+		// the revisions of the source don't exist in the clone
+		spec.Traffic = nil
+		config := configAnnotations(&svc.ObjectMeta)
+		applied[idx], revisions[idx], err = s.applyService(ctx, namespaceName, svc.Name, func(clone *v1alpha1.Service) {
+			clone.Spec = spec
+			setAnnotations(&clone.ObjectMeta, config)
+		})
+		if err != nil {
+			logger.Error(err, "failed to apply service", "serviceName", svc.Name)
+			return nil, err
+		}
+	}
+
+	// all services come up at the same time, there's no point in waiting for them one after the other
+	infos := make([]*pb.ServiceInfo, len(applied))
+	for idx, service := range applied {
+		ready, err := s.waitForService(ctx, service, logger.WithValues("serviceName", service.Name))
+		if err != nil {
+			logger.Error(err, "failed to watch service", "serviceName", service.Name)
+			return nil, err
+		}
+		infos[idx] = toServiceInfo(ready, revisions[idx])
+	}
+
+	return &pb.CloneEnvironmentReply{
+		ID:       initReply.ID,
+		Services: infos,
+	}, nil
+}
@@ -0,0 +1,248 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// k8s event reasons we translate into deploy events
+// everything else is only forwarded if it's a warning
+var deployEventTypes = map[string]pb.DeployEvent_DeployEventType{
+	"Scheduled": pb.DeployEvent_POD_SCHEDULED,
+	"Pulling":   pb.DeployEvent_IMAGE_PULLING,
+	"Pulled":    pb.DeployEvent_IMAGE_PULLED,
+	"Started":   pb.DeployEvent_CONTAINER_STARTED,
+}
+
+// DeployStream does the same as Deploy but keeps the client posted while the new revision comes up.
+// Progress is derived from the status of the haiku service and the k8s events of the pods
+// that belong to it.
+func (s *CliServer) DeployStream(req *pb.DeployRequest, stream pb.CliService_DeployStreamServer) error {
+	ctx := stream.Context()
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("deploy service", "image", req.Image)
+
+	// remember where the event stream is at before anything happens
+	existingEvents, err := s.k8sClient.CoreV1().Events(namespaceName).List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		logger.Error(err, "failed to list events")
+		return err
+	}
+
+	service, revision, err := s.deploy(ctx, namespaceName, req)
+	if err != nil {
+		logger.Error(err, "failed to deploy service")
+		return err
+	}
+
+	err = stream.Send(&pb.DeployEvent{
+		Type:      pb.DeployEvent_APPLIED,
+		Message:   fmt.Sprintf("service %s applied with image %s", service.Name, service.Spec.Image),
+		Object:    service.Name,
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = stream.Send(&pb.DeployEvent{
+		Type:      pb.DeployEvent_REVISION_CREATED,
+		Message:   fmt.Sprintf("revision %d created", revision),
+		Object:    service.Name,
+		Timestamp: time.Now().Unix(),
+		Revision:  revision,
+	})
+	if err != nil {
+		return err
+	}
+
+	serviceWatcher, err := s.haikuClient.ServingV1alpha1().Services(service.Namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", service.Name).String(),
+		ResourceVersion: service.ResourceVersion,
+	})
+	if err != nil {
+		logger.Error(err, "failed to create watcher for service")
+		return err
+	}
+	defer serviceWatcher.Stop()
+
+	eventWatcher, err := s.k8sClient.CoreV1().Events(service.Namespace).Watch(ctx, metav1.ListOptions{
+		ResourceVersion: existingEvents.ResourceVersion,
+	})
+	if err != nil {
+		logger.Error(err, "failed to create watcher for events")
+		return err
+	}
+	defer eventWatcher.Stop()
+
+	ticker := time.NewTicker(diagnoseInterval)
+	defer ticker.Stop()
+
+	seen := map[types.UID]bool{}
+	belongsToService := s.serviceEventFilter(ctx, service)
+	events := eventWatcher.ResultChan()
+	for {
+		select {
+		case <-ctx.Done():
+			// request timed out
+			return sendDeployFailed(stream, revision, codes.DeadlineExceeded, "Timeout", "the service didn't become ready in time")
+		case <-ticker.C:
+			failure, err := s.diagnoseRevision(ctx, service.Namespace, service.Spec.RevisionName)
+			if err != nil {
+				// diagnosing is best effort
+				logger.Error(err, "failed to diagnose service")
+				continue
+			} else if failure == nil {
+				continue
+			}
+
+			err = stream.Send(&pb.DeployEvent{
+				Type:      pb.DeployEvent_FAILED,
+				Message:   failure.Message,
+				Reason:    failure.Reason,
+				Object:    failure.Pod,
+				Timestamp: time.Now().Unix(),
+				Revision:  revision,
+			})
+			if err != nil {
+				return err
+			}
+			return s.podFailureError(ctx, service.Namespace, failure)
+		case event, ok := <-serviceWatcher.ResultChan():
+			if !ok {
+				return sendDeployFailed(stream, revision, codes.Unavailable, "WatchClosed", "lost track of the service")
+			}
+			svc, ok := event.Object.(*v1alpha1.Service)
+			if !ok {
+				logger.Error(fmt.Errorf("object was %T", event.Object), "couldn't cast event watcher object to service")
+				continue
+			}
+			ready, err := revisionReady(svc, service)
+			if err != nil {
+				if failure, _ := s.diagnoseRevision(ctx, service.Namespace, service.Spec.RevisionName); failure != nil {
+					err = s.podFailureError(ctx, service.Namespace, failure)
+				}
+				return sendDeployFailed(stream, revision, status.Code(err), "RevisionFailed", status.Convert(err).Message())
+			} else if ready {
+				return stream.Send(&pb.DeployEvent{
+					Type:      pb.DeployEvent_READY,
+					Message:   fmt.Sprintf("revision %d is serving", revision),
+					Object:    svc.Name,
+					Timestamp: time.Now().Unix(),
+					Revision:  revision,
+					URL:       svc.Status.URL,
+				})
+			}
+		case event, ok := <-events:
+			if !ok {
+				// the event stream is a nice to have, keep waiting for the service regardless
+				events = nil
+				continue
+			}
+			if event.Type != watch.Added && event.Type != watch.Modified {
+				continue
+			}
+			k8sEvent, ok := event.Object.(*corev1.Event)
+			if !ok || seen[k8sEvent.UID] || !belongsToService(k8sEvent) {
+				continue
+			}
+			seen[k8sEvent.UID] = true
+
+			deployEvent, ok := toDeployEvent(k8sEvent)
+			if !ok {
+				continue
+			}
+			deployEvent.Revision = revision
+			err = stream.Send(deployEvent)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sendDeployFailed(stream pb.CliService_DeployStreamServer, revision int64, code codes.Code, reason string, message string) error {
+	err := stream.Send(&pb.DeployEvent{
+		Type:      pb.DeployEvent_FAILED,
+		Message:   message,
+		Reason:    reason,
+		Timestamp: time.Now().Unix(),
+		Revision:  revision,
+	})
+	if err != nil {
+		return err
+	}
+	return status.Error(code, message)
+}
+
+// serviceEventFilter returns a func that tells whether a k8s event is about the service,
+// the revision it was written with or one of the pods of that revision.
+// Names alone don't tell (the pods of api-gateway start with api- as well),
+// so pods are looked up once and checked for their knative labels.
+func (s *CliServer) serviceEventFilter(ctx context.Context, service *v1alpha1.Service) func(*corev1.Event) bool {
+	pods := map[string]bool{}
+	return func(event *corev1.Event) bool {
+		involved := event.InvolvedObject
+		switch involved.Kind {
+		case "Pod":
+			belongs, ok := pods[involved.Name]
+			if !ok {
+				pod, err := s.k8sClient.CoreV1().Pods(service.Namespace).Get(ctx, involved.Name, metav1.GetOptions{})
+				if err != nil && !errors.IsNotFound(err) {
+					// try again with the next event about the pod
+					return false
+				}
+				// pods that are gone already can't be told apart, leave them out
+				belongs = err == nil && pod.Labels[labelKnativeService] == service.Name && pod.Labels[labelKnativeRevision] == service.Spec.RevisionName
+				pods[involved.Name] = belongs
+			}
+			return belongs
+		case "Revision":
+			return involved.Name == service.Spec.RevisionName
+		}
+		return involved.Name == service.Name
+	}
+}
+
+func toDeployEvent(event *corev1.Event) (*pb.DeployEvent, bool) {
+	eventType, ok := deployEventTypes[event.Reason]
+	if !ok && event.Type != corev1.EventTypeWarning {
+		return nil, false
+	} else if !ok {
+		eventType = pb.DeployEvent_WARNING
+	}
+
+	return &pb.DeployEvent{
+		Type:      eventType,
+		Message:   event.Message,
+		Reason:    event.Reason,
+		Object:    event.InvolvedObject.Name,
+		Timestamp: eventTimestamp(event).Unix(),
+	}, true
+}
+
+func eventTimestamp(event *corev1.Event) time.Time {
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	} else if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	storage "cloud.google.com/go/storage"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeployUrl builds and deploys an archive that was uploaded through a url handed out by GetServiceUploadUrl.
+// The upload url and the plain key of the archive both work.
+// It returns as soon as the build started, the build reconciler deploys the build once it's done.
+// GetBuild and GetBuildLogs follow along with the build id.
+// The url of the service is returned right away for services that exist already,
+// new services only have one once the build is deployed.
+func (s *CliServer) DeployUrl(ctx context.Context, req *pb.DeployUrlRequest) (*pb.DeployUrlReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	} else if err := validateServiceName(req.ServiceName); err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("deploy uploaded archive")
+
+	key, err := s.uploadKeyFromURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	prefix := getUploadKeyPrefix(req.EnvironmentName, req.ServiceName)
+	name := strings.TrimPrefix(key, prefix)
+	if !strings.HasPrefix(key, prefix) || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("%w: archive %s doesn't belong to service %s in environment %s", ErrInvalidArgument, key, req.ServiceName, req.EnvironmentName)
+	}
+
+	_, err = s.gcsClient.Bucket(s.uploadBucket).Object(key).Attrs(ctx)
+	if err != nil && goerrors.Is(err, storage.ErrObjectNotExist) {
+		logger.Info("archive doesn't exist", "key", key)
+		return nil, fmt.Errorf("%w: archive %s", ErrNotFound, key)
+	} else if err != nil {
+		logger.Error(err, "failed to look up archive", "key", key)
+		return nil, err
+	}
+
+	var serviceURL string
+	service, err := s.haikuClient.ServingV1alpha1().Services(namespaceName).Get(ctx, req.ServiceName, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "failed to get service")
+		return nil, err
+	} else if err == nil {
+		serviceURL = service.Status.URL
+	}
+
+	run, err := s.startBuild(ctx, namespaceName, req.EnvironmentName, req.ServiceName, key, logger)
+	if err != nil {
+		logger.Error(err, "failed to start build")
+		return nil, err
+	}
+
+	logger.Info("build started", "key", key, "buildID", run.Name)
+
+	return &pb.DeployUrlReply{
+		ID:  run.Name,
+		URL: serviceURL,
+	}, nil
+}
+
+// uploadKeyFromURL returns the key of the archive an upload url points to.
+// Signed urls are path style, the bucket comes first.
+// Anything without a scheme is taken as key.
+func (s *CliServer) uploadKeyFromURL(rawURL string) (string, error) {
+	uploadURL, err := url.Parse(rawURL)
+	if err != nil {
+		// the url is signed, don't echo it back
+		return "", fmt.Errorf("%w: malformed upload url", ErrInvalidArgument)
+	} else if uploadURL.Scheme == "" {
+		return strings.TrimPrefix(uploadURL.Path, "/"), nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(uploadURL.Path, "/"), "/", 2)
+	if len(parts) != 2 || parts[0] != s.uploadBucket {
+		return "", fmt.Errorf("%w: %s%s isn't an upload url", ErrInvalidArgument, uploadURL.Host, uploadURL.Path)
+	}
+	return parts[1], nil
+}
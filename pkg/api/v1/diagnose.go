@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// knative puts these labels on every pod of a service
+	labelKnativeService  = "serving.knative.dev/service"
+	labelKnativeRevision = "serving.knative.dev/revision"
+
+	errorDomain = "haiku.io"
+
+	// the condition the operator sets on a haiku service once its latest revision is serving
+	conditionReady = "Ready"
+
+	// how often pods are checked while waiting for a service
+	diagnoseInterval = 5 * time.Second
+	// how long a running container may stay unready before we give up on it
+	readinessGracePeriod = 2 * time.Minute
+	// how long a pod may wait for a node, the cluster might be scaling up
+	schedulingGracePeriod = 2 * time.Minute
+	// how long the last look at the pods may take once waiting timed out
+	diagnoseTimeout = 10 * time.Second
+	// that many log lines make it into an error
+	logTailLines = int64(50)
+)
+
+// container waiting reasons that won't fix themselves
+var terminalWaitingReasons = map[string]bool{
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CrashLoopBackOff":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+// podFailure describes why a pod of a service will never become ready.
+type podFailure struct {
+	Reason    string
+	Message   string
+	Pod       string
+	Container string
+	// whether the logs of interest are those of the previous container run
+	Previous bool
+}
+
+// diagnoseRevision looks for pods of a revision that are stuck for good.
+// It returns nil if nothing is obviously wrong (yet).
+func (s *CliServer) diagnoseRevision(ctx context.Context, namespaceName string, revisionName string) (*podFailure, error) {
+	pods, err := s.k8sClient.CoreV1().Pods(namespaceName).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{labelKnativeRevision: revisionName}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the quota is only looked at if there's a pod that waits for a node
+	quotaExhausted := false
+	for idx := range pods.Items {
+		if unschedulableCondition(&pods.Items[idx]) != nil {
+			quotaExhausted, err = s.quotaExhausted(ctx, namespaceName)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	for idx := range pods.Items {
+		pod := &pods.Items[idx]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if failure := diagnosePod(pod, quotaExhausted); failure != nil {
+			return failure, nil
+		}
+	}
+	return nil, nil
+}
+
+// diagnosePod tells why a pod is stuck for good.
+// A pod that can't be scheduled might get a node soon, unless the quota of its environment is used up.
+func diagnosePod(pod *corev1.Pod, quotaExhausted bool) *podFailure {
+	if condition := unschedulableCondition(pod); condition != nil {
+		if quotaExhausted {
+			return &podFailure{
+				Reason:  condition.Reason,
+				Message: condition.Message + " (the quota of the environment is used up)",
+				Pod:     pod.Name,
+			}
+		} else if time.Since(condition.LastTransitionTime.Time) > schedulingGracePeriod {
+			return &podFailure{
+				Reason:  condition.Reason,
+				Message: condition.Message,
+				Pod:     pod.Name,
+			}
+		}
+	}
+
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.State.Waiting != nil && terminalWaitingReasons[cs.State.Waiting.Reason] {
+			return &podFailure{
+				Reason:    cs.State.Waiting.Reason,
+				Message:   cs.State.Waiting.Message,
+				Pod:       pod.Name,
+				Container: cs.Name,
+				Previous:  cs.State.Waiting.Reason == "CrashLoopBackOff",
+			}
+		}
+
+		if cs.State.Running != nil && !cs.Ready && time.Since(cs.State.Running.StartedAt.Time) > readinessGracePeriod {
+			return &podFailure{
+				Reason:    "ReadinessFailed",
+				Message:   fmt.Sprintf("container %s has been running for more than %s without becoming ready", cs.Name, readinessGracePeriod),
+				Pod:       pod.Name,
+				Container: cs.Name,
+			}
+		}
+	}
+	return nil
+}
+
+func unschedulableCondition(pod *corev1.Pod) *corev1.PodCondition {
+	for idx, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && condition.Reason == corev1.PodReasonUnschedulable {
+			return &pod.Status.Conditions[idx]
+		}
+	}
+	return nil
+}
+
+// quotaExhausted tells whether any resource of a quota in a namespace is used up.
+func (s *CliServer) quotaExhausted(ctx context.Context, namespaceName string) (bool, error) {
+	quotas, err := s.k8sClient.CoreV1().ResourceQuotas(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	for _, quota := range quotas.Items {
+		for name, hard := range quota.Status.Hard {
+			if used, ok := quota.Status.Used[name]; ok && used.Cmp(hard) >= 0 {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// podFailureError turns a pod failure into a gRPC error.
+// The details carry the pod and the last lines the container logged.
+func (s *CliServer) podFailureError(ctx context.Context, namespaceName string, failure *podFailure) error {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("pod %s failed with %s: %s", failure.Pod, failure.Reason, failure.Message))
+	info := &errdetails.ErrorInfo{
+		Reason: failure.Reason,
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"pod":       failure.Pod,
+			"container": failure.Container,
+		},
+	}
+
+	withDetails, err := st.WithDetails(info)
+	if logs := s.tailLogs(ctx, namespaceName, failure); logs != "" {
+		withDetails, err = st.WithDetails(info, &errdetails.DebugInfo{
+			Detail: logs,
+		})
+	}
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// tailLogs returns the last lines the failed container logged.
+// Logs are best effort, not every failed container got to log something.
+func (s *CliServer) tailLogs(ctx context.Context, namespaceName string, failure *podFailure) string {
+	if failure.Container == "" {
+		return ""
+	}
+
+	tailLines := logTailLines
+	logs, err := s.k8sClient.CoreV1().Pods(namespaceName).GetLogs(failure.Pod, &corev1.PodLogOptions{
+		Container: failure.Container,
+		Previous:  failure.Previous,
+		TailLines: &tailLines,
+	}).Do(ctx).Raw()
+	if err != nil {
+		return ""
+	}
+	return string(logs)
+}
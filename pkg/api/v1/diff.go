@@ -0,0 +1,263 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const secretHashPrefix = "sha256:"
+
+// environmentState is what's compared when diffing environments.
+// Secret env values are replaced with their hashes as soon as they are read.
+type environmentState struct {
+	Services map[string]*pb.ServiceSpec
+	// server to username
+	RegistryLogins map[string]string
+}
+
+// DiffEnvironments compares the services (image, settings and env) and registry logins of an environment
+// to those of another environment or a desired spec.
+func (s *CliServer) DiffEnvironments(ctx context.Context, req *pb.DiffEnvironmentsRequest) (*pb.DiffEnvironmentsReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("diff environments", "otherEnvironmentName", req.GetOtherEnvironmentName())
+
+	var other *environmentState
+	switch o := req.Other.(type) {
+	case *pb.DiffEnvironmentsRequest_OtherEnvironmentName:
+		other, err = s.readEnvironmentState(ctx, o.OtherEnvironmentName)
+		if err != nil && IsNotFound(err) {
+			logger.Info("other environment doesn't exist")
+			return nil, err
+		} else if err != nil {
+			logger.Error(err, "failed to read other environment")
+			return nil, err
+		}
+	case *pb.DiffEnvironmentsRequest_Spec:
+		other, err = specState(o.Spec)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: either another environment or a spec is needed", ErrInvalidArgument)
+	}
+
+	state, err := s.readEnvironmentState(ctx, req.EnvironmentName)
+	if err != nil && IsNotFound(err) {
+		logger.Info("environment doesn't exist")
+		return nil, err
+	} else if err != nil {
+		logger.Error(err, "failed to read environment")
+		return nil, err
+	}
+
+	return diffEnvironmentStates(state, other), nil
+}
+
+// readEnvironmentState collects everything about an environment that's compared in a diff.
+func (s *CliServer) readEnvironmentState(ctx context.Context, environmentName string) (*environmentState, error) {
+	namespaceName, err := s.environmentNamespace(ctx, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := s.haikuClient.ServingV1alpha1().Services(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	state := &environmentState{
+		Services:       make(map[string]*pb.ServiceSpec, len(services.Items)),
+		RegistryLogins: map[string]string{},
+	}
+	for idx := range services.Items {
+		svc := &services.Items[idx]
+		env, secretEnv, err := s.getEnv(ctx, namespaceName, svc.Name)
+		if err != nil {
+			return nil, err
+		}
+		state.Services[svc.Name] = &pb.ServiceSpec{
+			Name:      svc.Name,
+			Image:     svc.Spec.Image,
+			Env:       env,
+			SecretEnv: hashSecrets(secretEnv),
+			Settings:  toPbSettings(&svc.Spec),
+		}
+	}
+
+	dockerLogins, err := s.haikuClient.EntitiesV1alpha1().DockerLogins(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, dl := range dockerLogins.Items {
+		state.RegistryLogins[dl.Spec.Server] = dl.Spec.Username
+	}
+	return state, nil
+}
+
+// specState turns a desired spec into something that can be diffed.
+func specState(spec *pb.EnvironmentSpec) (*environmentState, error) {
+	state := &environmentState{
+		Services:       make(map[string]*pb.ServiceSpec, len(spec.Services)),
+		RegistryLogins: make(map[string]string, len(spec.RegistryLogins)),
+	}
+	for _, svc := range spec.Services {
+		if _, ok := state.Services[svc.Name]; ok {
+			return nil, fmt.Errorf("%w: service %s is specified twice", ErrInvalidArgument, svc.Name)
+		}
+		settings := svc.Settings
+		if settings == nil {
+			settings = &pb.ServiceSettings{}
+		}
+		state.Services[svc.Name] = &pb.ServiceSpec{
+			Name:      svc.Name,
+			Image:     svc.Image,
+			Env:       svc.Env,
+			SecretEnv: hashSecrets(svc.SecretEnv),
+			Settings:  settings,
+		}
+	}
+	for _, login := range spec.RegistryLogins {
+		state.RegistryLogins[login.Server] = login.Username
+	}
+	return state, nil
+}
+
+func diffEnvironmentStates(oldState *environmentState, newState *environmentState) *pb.DiffEnvironmentsReply {
+	reply := &pb.DiffEnvironmentsReply{}
+	for name, oldService := range oldState.Services {
+		newService, ok := newState.Services[name]
+		if !ok {
+			reply.Services = append(reply.Services, diffService(pb.EnvChange_REMOVED, oldService, &pb.ServiceSpec{Name: name}))
+		} else if serviceDiff := diffService(pb.EnvChange_CHANGED, oldService, newService); len(serviceDiff.Fields) > 0 || len(serviceDiff.Env) > 0 {
+			reply.Services = append(reply.Services, serviceDiff)
+		}
+	}
+	for name, newService := range newState.Services {
+		if _, ok := oldState.Services[name]; !ok {
+			reply.Services = append(reply.Services, diffService(pb.EnvChange_ADDED, &pb.ServiceSpec{Name: name}, newService))
+		}
+	}
+	sort.Slice(reply.Services, func(i, j int) bool {
+		return reply.Services[i].Name < reply.Services[j].Name
+	})
+
+	for server, oldUsername := range oldState.RegistryLogins {
+		newUsername, ok := newState.RegistryLogins[server]
+		if !ok {
+			reply.RegistryLogins = append(reply.RegistryLogins, &pb.RegistryLoginChange{Server: server, Type: pb.EnvChange_REMOVED, OldUsername: oldUsername})
+		} else if oldUsername != newUsername {
+			reply.RegistryLogins = append(reply.RegistryLogins, &pb.RegistryLoginChange{Server: server, Type: pb.EnvChange_CHANGED, OldUsername: oldUsername, NewUsername: newUsername})
+		}
+	}
+	for server, newUsername := range newState.RegistryLogins {
+		if _, ok := oldState.RegistryLogins[server]; !ok {
+			reply.RegistryLogins = append(reply.RegistryLogins, &pb.RegistryLoginChange{Server: server, Type: pb.EnvChange_ADDED, NewUsername: newUsername})
+		}
+	}
+	sort.Slice(reply.RegistryLogins, func(i, j int) bool {
+		return reply.RegistryLogins[i].Server < reply.RegistryLogins[j].Server
+	})
+
+	reply.Identical = len(reply.Services) == 0 && len(reply.RegistryLogins) == 0
+	return reply
+}
+
+func diffService(changeType pb.EnvChange_ChangeType, oldService *pb.ServiceSpec, newService *pb.ServiceSpec) *pb.ServiceDiff {
+	oldFields := serviceFields(oldService)
+	newFields := serviceFields(newService)
+	fields := []*pb.FieldChange{}
+	for idx := range oldFields {
+		if !oldFields[idx].equal(newFields[idx]) {
+			fields = append(fields, &pb.FieldChange{
+				Field:    oldFields[idx].name,
+				OldValue: oldFields[idx].value,
+				NewValue: newFields[idx].value,
+			})
+		}
+	}
+
+	return &pb.ServiceDiff{
+		Name:   newService.Name,
+		Type:   changeType,
+		Fields: fields,
+		Env:    toPbEnvChanges(diffEnv(oldService.Env, oldService.SecretEnv, newService.Env, newService.SecretEnv)),
+	}
+}
+
+type serviceField struct {
+	name  string
+	value string
+	// quantities are compared by amount, 0.5 and 500m cpu are the same
+	quantity bool
+}
+
+func (f serviceField) equal(other serviceField) bool {
+	if f.quantity {
+		// anything that isn't a quantity is compared as is, settings are validated elsewhere
+		q, err := resource.ParseQuantity(f.value)
+		otherQ, otherErr := resource.ParseQuantity(other.value)
+		if err == nil && otherErr == nil {
+			return q.Cmp(otherQ) == 0
+		}
+	}
+	return f.value == other.value
+}
+
+// serviceFields flattens everything but the env of a service in a fixed order.
+// Unset values are empty strings.
+func serviceFields(svc *pb.ServiceSpec) []serviceField {
+	itoa := func(i int32) string {
+		if i == 0 {
+			return ""
+		}
+		return strconv.Itoa(int(i))
+	}
+	settings := svc.Settings
+	if settings == nil {
+		settings = &pb.ServiceSettings{}
+	}
+	return []serviceField{
+		{name: "image", value: svc.Image},
+		{name: "cpu", value: settings.CPU, quantity: true},
+		{name: "memory", value: settings.Memory, quantity: true},
+		{name: "minReplicas", value: itoa(settings.MinReplicas)},
+		{name: "maxReplicas", value: itoa(settings.MaxReplicas)},
+		{name: "concurrency", value: itoa(settings.Concurrency)},
+	}
+}
+
+// hashSecrets replaces secret values with their hashes.
+// Values that are hashes already are left alone, that way a spec doesn't need to contain secrets.
+func hashSecrets(secretEnv map[string]string) map[string]string {
+	hashed := make(map[string]string, len(secretEnv))
+	for key, value := range secretEnv {
+		hashed[key] = hashSecret(value)
+	}
+	return hashed
+}
+
+func hashSecret(value string) string {
+	if isSecretHash(value) {
+		return value
+	}
+	sum := sha256.Sum256([]byte(value))
+	return secretHashPrefix + hex.EncodeToString(sum[:])
+}
+
+func isSecretHash(value string) bool {
+	return strings.HasPrefix(value, secretHashPrefix) && len(value) == len(secretHashPrefix)+sha256.Size*2
+}
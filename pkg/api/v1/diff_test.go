@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/manifest"
+)
+
+// TestApplyDiff compares an environment to manifests the way Apply does.
+func TestApplyDiff(t *testing.T) {
+	// what readEnvironmentState reports, k8s renders quantities canonically
+	current := &environmentState{
+		Services: map[string]*pb.ServiceSpec{
+			"api": {
+				Name:      "api",
+				Image:     "gcr.io/acme/api:1.2.3",
+				Env:       map[string]string{"LOG_LEVEL": "info"},
+				SecretEnv: hashSecrets(map[string]string{"DB_PASSWORD": "hunter2"}),
+				Settings: &pb.ServiceSettings{
+					CPU:         "500m",
+					Memory:      "256Mi",
+					MaxReplicas: 5,
+				},
+			},
+		},
+		RegistryLogins: map[string]string{},
+	}
+
+	service := func(mutate func(*manifest.Service)) manifest.Service {
+		svc := manifest.Service{
+			Name:      "api",
+			Image:     "gcr.io/acme/api:1.2.3",
+			Env:       map[string]string{"LOG_LEVEL": "info"},
+			SecretEnv: map[string]string{"DB_PASSWORD": "hunter2"},
+			Scaling: &manifest.Scaling{
+				CPU:         "500m",
+				Memory:      "256Mi",
+				MaxReplicas: 5,
+			},
+		}
+		mutate(&svc)
+		return svc
+	}
+
+	tests := []struct {
+		name       string
+		services   []manifest.Service
+		wantFields []string
+		wantEnv    []string
+	}{
+		{
+			name:     "no changes",
+			services: []manifest.Service{service(func(*manifest.Service) {})},
+		},
+		{
+			name: "quantities written differently",
+			services: []manifest.Service{service(func(svc *manifest.Service) {
+				svc.Scaling.CPU = "0.5"
+				svc.Scaling.Memory = "268435456"
+			})},
+		},
+		{
+			name: "secret given as hash",
+			services: []manifest.Service{service(func(svc *manifest.Service) {
+				svc.SecretEnv = hashSecrets(svc.SecretEnv)
+			})},
+		},
+		{
+			name: "image and cpu changed",
+			services: []manifest.Service{service(func(svc *manifest.Service) {
+				svc.Image = "gcr.io/acme/api:1.2.4"
+				svc.Scaling.CPU = "1"
+			})},
+			wantFields: []string{"image", "cpu"},
+		},
+		{
+			name: "env changed",
+			services: []manifest.Service{service(func(svc *manifest.Service) {
+				svc.Env = map[string]string{"LOG_LEVEL": "debug"}
+			})},
+			wantEnv: []string{"LOG_LEVEL"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &manifest.Manifest{
+				APIVersion: manifest.APIVersion,
+				Kind:       manifest.Kind,
+				Metadata:   manifest.Metadata{Name: "staging"},
+				Spec:       manifest.Spec{Services: tt.services},
+			}
+			desired, err := specState(toEnvironmentSpec(m))
+			if err != nil {
+				t.Fatalf("specState() error = %v", err)
+			}
+
+			diff := diffEnvironmentStates(current, desired)
+			identical := len(tt.wantFields) == 0 && len(tt.wantEnv) == 0
+			if diff.Identical != identical {
+				t.Fatalf("Identical = %v, want %v: %+v", diff.Identical, identical, diff.Services)
+			}
+			if identical {
+				return
+			}
+
+			var fields, env []string
+			for _, field := range diff.Services[0].Fields {
+				fields = append(fields, field.Field)
+			}
+			for _, change := range diff.Services[0].Env {
+				env = append(env, change.Key)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("fields = %v, want %v", fields, tt.wantFields)
+			}
+			if !reflect.DeepEqual(env, tt.wantEnv) {
+				t.Errorf("env = %v, want %v", env, tt.wantEnv)
+			}
+		})
+	}
+}
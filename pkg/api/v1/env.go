@@ -0,0 +1,584 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/dotenv"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// secret values are never handed out, this is what clients see instead
+	maskedValue = "********"
+
+	// how long putting back a half-written env may take
+	envRestoreTimeout = 10 * time.Second
+)
+
+// The env family of endpoints is stored as a k8s configmap (and a k8s secret for sensitive values).
+// There's one of each per service in the environment namespace.
+// They carry the haiku labels so that we can find them again.
+// A key lives in either of the two but never in both.
+// The haiku service doesn't read them directly, deploys point it at immutable copies (see pinEnv).
+func (s *CliServer) ListEnv(ctx context.Context, req *pb.ListEnvRequest) (*pb.ListEnvReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("list env")
+	env, secretEnv, err := s.getEnv(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to get env")
+		return nil, err
+	}
+
+	list := make([]*pb.ListEnvReply_KeyValue, 0, len(env)+len(secretEnv))
+	for key, value := range env {
+		list = append(list, &pb.ListEnvReply_KeyValue{
+			Key:   key,
+			Value: value,
+		})
+	}
+	for key := range secretEnv {
+		list = append(list, &pb.ListEnvReply_KeyValue{
+			Key:    key,
+			Value:  maskedValue,
+			Secret: true,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Key < list[j].Key
+	})
+
+	return &pb.ListEnvReply{
+		List: list,
+	}, nil
+}
+
+func (s *CliServer) SetEnv(ctx context.Context, req *pb.SetEnvRequest) (*pb.SetEnvReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("set env", "key", req.Key, "secret", req.Secret)
+	if errs := validation.IsEnvVarName(req.Key); len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArgument, strings.Join(errs, ", "))
+	}
+
+	_, err = s.changeEnv(ctx, namespaceName, req.ServiceName, func(env map[string]string, secretEnv map[string]string) error {
+		putEnv(env, secretEnv, req.Secret, map[string]string{req.Key: req.Value})
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "failed to update env")
+		return nil, err
+	}
+
+	err = s.rolloutEnv(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to roll out env")
+		return nil, err
+	}
+
+	return &pb.SetEnvReply{
+		Success: true,
+	}, nil
+}
+
+func (s *CliServer) RemoveEnv(ctx context.Context, req *pb.RemoveEnvRequest) (*pb.RemoveEnvReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("remove env", "key", req.Key)
+	_, err = s.changeEnv(ctx, namespaceName, req.ServiceName, func(env map[string]string, secretEnv map[string]string) error {
+		for _, data := range []map[string]string{env, secretEnv} {
+			if _, ok := data[req.Key]; ok {
+				delete(data, req.Key)
+				return nil
+			}
+		}
+		return ErrNotFound
+	})
+	if err != nil && IsNotFound(err) {
+		logger.Info("env key doesn't exist")
+		return nil, err
+	} else if err != nil {
+		logger.Error(err, "failed to update env")
+		return nil, err
+	}
+
+	err = s.rolloutEnv(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to roll out env")
+		return nil, err
+	}
+
+	return &pb.RemoveEnvReply{
+		Success: true,
+	}, nil
+}
+
+// ImportEnv applies a whole dotenv payload to the env of a service.
+// The payload is validated upfront and the env is written as a whole (see writeEnv),
+// so that a failed import doesn't leave a half-applied env behind.
+func (s *CliServer) ImportEnv(ctx context.Context, req *pb.ImportEnvRequest) (*pb.ImportEnvReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("import env", "mode", req.Mode.String(), "secret", req.Secret)
+	imported, err := dotenv.Parse(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArgument, err.Error())
+	}
+
+	for _, key := range sortedKeys(imported) {
+		if errs := validation.IsEnvVarName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("%w: %s: %s", ErrInvalidArgument, key, strings.Join(errs, ", "))
+		}
+	}
+
+	_, err = s.changeEnv(ctx, namespaceName, req.ServiceName, func(env map[string]string, secretEnv map[string]string) error {
+		if req.Mode == pb.ImportMode_REPLACE {
+			replaced := env
+			if req.Secret {
+				replaced = secretEnv
+			}
+			for key := range replaced {
+				delete(replaced, key)
+			}
+		}
+		putEnv(env, secretEnv, req.Secret, imported)
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "failed to update env")
+		return nil, err
+	}
+
+	err = s.rolloutEnv(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to roll out env")
+		return nil, err
+	}
+
+	return &pb.ImportEnvReply{
+		Count: int32(len(imported)),
+	}, nil
+}
+
+func (s *CliServer) ExportEnv(ctx context.Context, req *pb.ExportEnvRequest) (*pb.ExportEnvReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("export env")
+	env, secretEnv, err := s.getEnv(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to get env")
+		return nil, err
+	}
+
+	var sb strings.Builder
+	for _, key := range sortedKeys(secretEnv) {
+		fmt.Fprintf(&sb, "# %s is a secret\n", key)
+	}
+	sb.WriteString(dotenv.Format(env))
+
+	return &pb.ExportEnvReply{
+		Data: sb.String(),
+	}, nil
+}
+
+// putEnv sets values in either the plain or the secret env and removes their keys from the other one.
+// That way a key lives in exactly one place.
+// Secret values only ever end up in k8s secrets, the copy the haiku service reads included.
+func putEnv(env map[string]string, secretEnv map[string]string, secret bool, values map[string]string) {
+	to, other := env, secretEnv
+	if secret {
+		to, other = secretEnv, env
+	}
+	for key, value := range values {
+		to[key] = value
+		delete(other, key)
+	}
+}
+
+// replaceEnv swaps the whole env of a service for the given one.
+func (s *CliServer) replaceEnv(ctx context.Context, namespaceName string, serviceName string, env map[string]string, secretEnv map[string]string) (int64, error) {
+	return s.changeEnv(ctx, namespaceName, serviceName, func(currentEnv map[string]string, currentSecretEnv map[string]string) error {
+		for _, pair := range [][2]map[string]string{{currentEnv, env}, {currentSecretEnv, secretEnv}} {
+			for key := range pair[0] {
+				delete(pair[0], key)
+			}
+			for key, value := range pair[1] {
+				pair[0][key] = value
+			}
+		}
+		return nil
+	})
+}
+
+// getEnv returns the plain and the secret env of a service.
+func (s *CliServer) getEnv(ctx context.Context, namespaceName string, serviceName string) (map[string]string, map[string]string, error) {
+	cm, secret, err := s.getEnvObjects(ctx, namespaceName, serviceName)
+	if err != nil {
+		return nil, nil, err
+	}
+	env, secretEnv := envData(cm, secret)
+	return env, secretEnv, nil
+}
+
+// getEnvObjects returns the env configmap and secret of a service.
+// Either is nil if it doesn't exist (yet).
+func (s *CliServer) getEnvObjects(ctx context.Context, namespaceName string, serviceName string) (*corev1.ConfigMap, *corev1.Secret, error) {
+	cm, err := s.k8sClient.CoreV1().ConfigMaps(namespaceName).Get(ctx, envConfigMapName(serviceName), metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		cm = nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	secret, err := s.k8sClient.CoreV1().Secrets(namespaceName).Get(ctx, envSecretName(serviceName), metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		secret = nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	return cm, secret, nil
+}
+
+// envData copies the env out of the env configmap and secret of a service.
+func envData(cm *corev1.ConfigMap, secret *corev1.Secret) (map[string]string, map[string]string) {
+	env := map[string]string{}
+	if cm != nil {
+		for key, value := range cm.Data {
+			env[key] = value
+		}
+	}
+
+	secretEnv := map[string]string{}
+	if secret != nil {
+		for key, value := range secret.Data {
+			secretEnv[key] = string(value)
+		}
+	}
+	return env, secretEnv
+}
+
+// writeEnv replaces the env of a service with the given one.
+// cm and secret are what the env was read from (nil if they didn't exist),
+// if somebody else changed them in the meantime the write fails with a conflict.
+// The env is written as a whole or not at all, if the secret can't be written
+// the configmap is put back the way it was.
+func (s *CliServer) writeEnv(ctx context.Context, namespaceName string, serviceName string, cm *corev1.ConfigMap, secret *corev1.Secret, env map[string]string, secretEnv map[string]string) error {
+	written, err := s.writeEnvConfigMap(ctx, namespaceName, serviceName, cm, env)
+	if err != nil {
+		return err
+	}
+	_, err = s.writeEnvSecret(ctx, namespaceName, serviceName, secret, secretEnv)
+	if err == nil {
+		return nil
+	}
+
+	restoreErr := s.restoreEnvConfigMap(ctx, cm, written)
+	if restoreErr != nil {
+		s.logger.Error(restoreErr, "failed to restore env configmap", "namespaceName", namespaceName, "serviceName", serviceName)
+	}
+	return err
+}
+
+// restoreEnvConfigMap undoes a write of the env configmap of a service.
+// The request might be over already (that's one way for the secret to fail), so it runs on a context of its own.
+func (s *CliServer) restoreEnvConfigMap(ctx context.Context, original *corev1.ConfigMap, written *corev1.ConfigMap) error {
+	if written == nil || (original != nil && written.ResourceVersion == original.ResourceVersion) {
+		// nothing was written
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, envRestoreTimeout)
+	defer cancel()
+	configMaps := s.k8sClient.CoreV1().ConfigMaps(written.Namespace)
+	if original == nil {
+		return configMaps.Delete(ctx, written.Name, *metav1.NewRVDeletionPrecondition(written.ResourceVersion))
+	}
+
+	restored := written.DeepCopy()
+	restored.Data = original.Data
+	_, err := configMaps.Update(ctx, restored, metav1.UpdateOptions{})
+	return err
+}
+
+// writeEnvConfigMap writes data to the env configmap of a service.
+// The configmap is only created if there's something to put in it.
+// It returns the configmap as written, nil if it doesn't exist.
+func (s *CliServer) writeEnvConfigMap(ctx context.Context, namespaceName string, serviceName string, cm *corev1.ConfigMap, data map[string]string) (*corev1.ConfigMap, error) {
+	configMaps := s.k8sClient.CoreV1().ConfigMaps(namespaceName)
+	if cm == nil && len(data) == 0 {
+		return nil, nil
+	} else if cm == nil {
+		return configMaps.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      envConfigMapName(serviceName),
+				Labels:    serviceLabels(serviceName, componentEnv),
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+	}
+
+	current, _ := envData(cm, nil)
+	if reflect.DeepEqual(current, data) {
+		return cm, nil
+	}
+	updated := cm.DeepCopy()
+	updated.Data = data
+	return configMaps.Update(ctx, updated, metav1.UpdateOptions{})
+}
+
+// writeEnvSecret is the secret equivalent of writeEnvConfigMap.
+func (s *CliServer) writeEnvSecret(ctx context.Context, namespaceName string, serviceName string, secret *corev1.Secret, data map[string]string) (*corev1.Secret, error) {
+	secrets := s.k8sClient.CoreV1().Secrets(namespaceName)
+	if secret == nil && len(data) == 0 {
+		return nil, nil
+	} else if secret == nil {
+		return secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      envSecretName(serviceName),
+				Labels:    serviceLabels(serviceName, componentEnv),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: toSecretData(data),
+		}, metav1.CreateOptions{})
+	}
+
+	_, current := envData(nil, secret)
+	if reflect.DeepEqual(current, data) {
+		return secret, nil
+	}
+	updated := secret.DeepCopy()
+	updated.Data = toSecretData(data)
+	return secrets.Update(ctx, updated, metav1.UpdateOptions{})
+}
+
+// rolloutEnv rolls the env of a service out as new revision of the haiku service.
+// If the service hasn't been deployed yet, the env is picked up by the first deploy.
+func (s *CliServer) rolloutEnv(ctx context.Context, namespaceName string, serviceName string) error {
+	_, err := s.haikuClient.ServingV1alpha1().Services(namespaceName).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	_, _, err = s.applyService(ctx, namespaceName, serviceName, func(*v1alpha1.Service) {})
+	return err
+}
+
+// pinEnv copies the current env of a service into an immutable configmap and secret
+// and returns the env sources that point the haiku service at them.
+// There's one copy per env revision, that way every revision of the haiku service
+// keeps the env it was deployed with no matter what happens to the env afterwards.
+// It also returns the env revision that was pinned.
+func (s *CliServer) pinEnv(ctx context.Context, namespaceName string, serviceName string) ([]corev1.EnvFromSource, int64, error) {
+	envRevision, err := s.latestEnvRevision(ctx, namespaceName, serviceName)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var env, secretEnv map[string]string
+	if envRevision == 0 {
+		// the env was never changed through haiku, there's no snapshot to copy from
+		env, secretEnv, err = s.getEnv(ctx, namespaceName, serviceName)
+		if err != nil {
+			return nil, 0, err
+		}
+	} else {
+		snapshot, err := s.k8sClient.CoreV1().Secrets(namespaceName).Get(ctx, envRevisionName(serviceName, envRevision), metav1.GetOptions{})
+		if err != nil {
+			return nil, 0, err
+		}
+		env, secretEnv = fromEnvSnapshot(snapshot.Data)
+	}
+
+	copyLabels := serviceLabels(serviceName, componentEnvCopy)
+	copyLabels[labelRevision] = strconv.FormatInt(envRevision, 10)
+	immutable := true
+	var envFrom []corev1.EnvFromSource
+	if len(env) > 0 {
+		name := envCopyConfigMapName(serviceName, envRevision)
+		_, err = s.k8sClient.CoreV1().ConfigMaps(namespaceName).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      name,
+				Labels:    copyLabels,
+			},
+			Immutable: &immutable,
+			Data:      env,
+		}, metav1.CreateOptions{})
+		if err != nil && errors.IsAlreadyExists(err) {
+			var existing *corev1.ConfigMap
+			existing, err = s.k8sClient.CoreV1().ConfigMaps(namespaceName).Get(ctx, name, metav1.GetOptions{})
+			if err == nil {
+				err = checkEnvCopy(&existing.ObjectMeta, copyLabels)
+			}
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		envFrom = append(envFrom, corev1.EnvFromSource{
+			ConfigMapRef: &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			},
+		})
+	}
+	if len(secretEnv) > 0 {
+		name := envCopySecretName(serviceName, envRevision)
+		_, err = s.k8sClient.CoreV1().Secrets(namespaceName).Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      name,
+				Labels:    copyLabels,
+			},
+			Immutable: &immutable,
+			Type:      corev1.SecretTypeOpaque,
+			Data:      toSecretData(secretEnv),
+		}, metav1.CreateOptions{})
+		if err != nil && errors.IsAlreadyExists(err) {
+			var existing *corev1.Secret
+			existing, err = s.k8sClient.CoreV1().Secrets(namespaceName).Get(ctx, name, metav1.GetOptions{})
+			if err == nil {
+				err = checkEnvCopy(&existing.ObjectMeta, copyLabels)
+			}
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		envFrom = append(envFrom, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			},
+		})
+	}
+	return envFrom, envRevision, nil
+}
+
+// checkEnvCopy makes sure an env copy that exists already is the one of the same service and env revision.
+// A service must never be pointed at anything else, that could be the env (or the secrets) of another service.
+func checkEnvCopy(meta *metav1.ObjectMeta, copyLabels map[string]string) error {
+	for key, value := range copyLabels {
+		if meta.Labels[key] != value {
+			return status.Errorf(codes.FailedPrecondition, "%s exists but isn't the env copy of service %s at env revision %s", meta.Name, copyLabels[labelService], copyLabels[labelRevision])
+		}
+	}
+	return nil
+}
+
+// pruneEnvCopies deletes the env copies no recorded revision points at anymore.
+// The copy of the current env revision stays, it's what the next deploy points at.
+func (s *CliServer) pruneEnvCopies(ctx context.Context, namespaceName string, serviceName string) error {
+	revisions, err := s.listRevisions(ctx, namespaceName, serviceName)
+	if err != nil {
+		return err
+	}
+	latestEnvRevision, err := s.latestEnvRevision(ctx, namespaceName, serviceName)
+	if err != nil {
+		return err
+	}
+
+	inUse := map[string]bool{
+		strconv.FormatInt(latestEnvRevision, 10): true,
+	}
+	for _, cm := range revisions {
+		inUse[cm.Data[revisionDataEnvRevision]] = true
+	}
+
+	listOptions := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(serviceLabels(serviceName, componentEnvCopy)).String(),
+	}
+	configMaps, err := s.k8sClient.CoreV1().ConfigMaps(namespaceName).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, cm := range configMaps.Items {
+		if inUse[cm.Labels[labelRevision]] {
+			continue
+		}
+		err = s.k8sClient.CoreV1().ConfigMaps(namespaceName).Delete(ctx, cm.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	secrets, err := s.k8sClient.CoreV1().Secrets(namespaceName).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets.Items {
+		if inUse[secret.Labels[labelRevision]] {
+			continue
+		}
+		err = s.k8sClient.CoreV1().Secrets(namespaceName).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func envConfigMapName(serviceName string) string {
+	return "haiku-env-" + serviceName
+}
+
+func envSecretName(serviceName string) string {
+	return "haiku-secret-env-" + serviceName
+}
+
+// The names of env copies have a prefix of their own.
+// Sharing the one of the live env would let the copy of "api" at revision 1 clash with the live env of "api-v1".
+func envCopyConfigMapName(serviceName string, envRevision int64) string {
+	return fmt.Sprintf("haiku-envpin-%s-v%d", serviceName, envRevision)
+}
+
+func envCopySecretName(serviceName string, envRevision int64) string {
+	return fmt.Sprintf("haiku-secret-envpin-%s-v%d", serviceName, envRevision)
+}
+
+func toSecretData(data map[string]string) map[string][]byte {
+	secretData := make(map[string][]byte, len(data))
+	for key, value := range data {
+		secretData[key] = []byte(value)
+	}
+	return secretData
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
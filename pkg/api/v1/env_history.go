@@ -0,0 +1,400 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// that many env revisions are kept around per service
+	envRevisionHistoryLimit = 50
+
+	// env vars and secret env vars share the same snapshot
+	// their keys are prefixed to tell them apart
+	snapshotPlainPrefix  = "plain."
+	snapshotSecretPrefix = "secret."
+	// the diff to the previous env revision sits next to the snapshot
+	// annotations are too small for diffs of big envs
+	snapshotDiffKey = "diff"
+)
+
+// Every change to the env of a service is recorded as env revision.
+// An env revision is a k8s secret (it contains secret values after all) that holds
+// a snapshot of the entire env after the change and what changed.
+// Who made the change is kept in its annotations.
+// Env revisions that can't be read are left out.
+func (s *CliServer) ListEnvRevisions(ctx context.Context, req *pb.ListEnvRevisionsRequest) (*pb.ListEnvRevisionsReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("list env revisions")
+	secrets, err := s.listEnvRevisions(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to list env revisions")
+		return nil, err
+	}
+
+	revisions := make([]*pb.EnvRevision, 0, len(secrets))
+	for idx := range secrets {
+		if isPending(&secrets[idx].ObjectMeta) {
+			continue
+		}
+		revision, err := toEnvRevision(&secrets[idx])
+		if err != nil {
+			logger.Error(err, "failed to read env revision", "name", secrets[idx].Name)
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return &pb.ListEnvRevisionsReply{
+		Revisions: revisions,
+	}, nil
+}
+
+// RestoreEnvRevision replaces the entire env of a service with the snapshot of a previous env revision.
+func (s *CliServer) RestoreEnvRevision(ctx context.Context, req *pb.RestoreEnvRevisionRequest) (*pb.RestoreEnvRevisionReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("restore env revision", "revision", req.Revision)
+	snapshot, err := s.k8sClient.CoreV1().Secrets(namespaceName).Get(ctx, envRevisionName(req.ServiceName, req.Revision), metav1.GetOptions{})
+	if (err != nil && errors.IsNotFound(err)) || (err == nil && isPending(&snapshot.ObjectMeta)) {
+		logger.Info("env revision doesn't exist")
+		return nil, ErrNotFound
+	} else if err != nil {
+		logger.Error(err, "failed to get env revision")
+		return nil, err
+	}
+
+	revision, err := s.restoreEnvSnapshot(ctx, namespaceName, req.ServiceName, snapshot.Data)
+	if err != nil {
+		logger.Error(err, "failed to restore env revision")
+		return nil, err
+	}
+
+	err = s.rolloutEnv(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to roll out env")
+		return nil, err
+	}
+
+	return &pb.RestoreEnvRevisionReply{
+		Revision: revision,
+	}, nil
+}
+
+// restoreEnvSnapshot replaces the entire env of a service with the contents of a snapshot.
+func (s *CliServer) restoreEnvSnapshot(ctx context.Context, namespaceName string, serviceName string, snapshot map[string][]byte) (int64, error) {
+	env, secretEnv := fromEnvSnapshot(snapshot)
+	return s.replaceEnv(ctx, namespaceName, serviceName, env, secretEnv)
+}
+
+// changeEnv applies change to a copy of the env of a service and writes the result.
+// The change is recorded as new env revision before anything is written. The env revision is pending
+// until the env is written, nobody pins it or restores it before. If the env can't be written, it goes away again.
+// If somebody else changed the env in the meantime, the whole thing starts over.
+// The new env isn't rolled out, that's up to the caller (see rolloutEnv),
+// callers that deploy right after don't need an extra revision of the haiku service.
+// It returns the number of the new env revision.
+func (s *CliServer) changeEnv(ctx context.Context, namespaceName string, serviceName string, change func(env map[string]string, secretEnv map[string]string) error) (int64, error) {
+	var revision int64
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, secret, err := s.getEnvObjects(ctx, namespaceName, serviceName)
+		if err != nil {
+			return err
+		}
+		oldEnv, oldSecretEnv := envData(cm, secret)
+		env, secretEnv := envData(cm, secret)
+		err = change(env, secretEnv)
+		if err != nil {
+			return err
+		}
+
+		revision, err = s.recordEnvRevision(ctx, namespaceName, serviceName, oldEnv, oldSecretEnv, env, secretEnv)
+		if err != nil {
+			return err
+		}
+
+		err = s.writeEnv(ctx, namespaceName, serviceName, cm, secret, env, secretEnv)
+		if err != nil {
+			// the env revision describes a change that didn't happen
+			dropErr := s.k8sClient.CoreV1().Secrets(namespaceName).Delete(ctx, envRevisionName(serviceName, revision), metav1.DeleteOptions{})
+			if dropErr != nil && !errors.IsNotFound(dropErr) {
+				s.logger.Error(dropErr, "failed to drop env revision", "namespaceName", namespaceName, "serviceName", serviceName, "revision", revision)
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.commitEnvRevision(ctx, namespaceName, serviceName, revision)
+	if err != nil {
+		s.logger.Error(err, "failed to commit env revision", "namespaceName", namespaceName, "serviceName", serviceName, "revision", revision)
+		return 0, err
+	}
+
+	s.pruneEnvRevisions(ctx, namespaceName, serviceName, revision)
+	return revision, nil
+}
+
+// commitEnvRevision marks an env revision as written.
+// The env is written already at this point, so this happens even if the request is over.
+func (s *CliServer) commitEnvRevision(ctx context.Context, namespaceName string, serviceName string, revision int64) error {
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, envRestoreTimeout)
+	defer cancel()
+
+	secrets := s.k8sClient.CoreV1().Secrets(namespaceName)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		snapshot, err := secrets.Get(ctx, envRevisionName(serviceName, revision), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		delete(snapshot.Labels, labelPending)
+		_, err = secrets.Update(ctx, snapshot, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// recordEnvRevision snapshots the env of a service after a change and diffs it against the env before.
+// Revision numbers are handed out by trying to create the next one and retrying if somebody else was faster.
+// The env revision starts out pending, see commitEnvRevision.
+func (s *CliServer) recordEnvRevision(ctx context.Context, namespaceName string, serviceName string, oldEnv map[string]string, oldSecretEnv map[string]string, env map[string]string, secretEnv map[string]string) (int64, error) {
+	diff, err := json.Marshal(diffEnv(oldEnv, oldSecretEnv, env, secretEnv))
+	if err != nil {
+		return 0, err
+	}
+	data := toEnvSnapshot(env, secretEnv)
+	data[snapshotDiffKey] = diff
+
+	secrets := s.k8sClient.CoreV1().Secrets(namespaceName)
+	var revision int64
+	err = retry.OnError(retry.DefaultRetry, errors.IsAlreadyExists, func() error {
+		// pending env revisions have their number already
+		existing, err := s.listEnvRevisions(ctx, namespaceName, serviceName)
+		if err != nil {
+			return err
+		}
+		revision = 1
+		if len(existing) > 0 {
+			revision = revisionNumber(&existing[len(existing)-1].ObjectMeta) + 1
+		}
+
+		secretLabels := serviceLabels(serviceName, componentEnvRevision)
+		secretLabels[labelRevision] = strconv.FormatInt(revision, 10)
+		secretLabels[labelPending] = "true"
+		_, err = secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      envRevisionName(serviceName, revision),
+				Labels:    secretLabels,
+				Annotations: map[string]string{
+					annotationChangedBy: callerFromContext(ctx),
+					annotationRequestID: requestid.FromContext(ctx),
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	})
+	return revision, err
+}
+
+// pruneEnvRevisions deletes the env revisions that have fallen out of the history.
+// Pruning is best effort, whatever is left is pruned next time.
+func (s *CliServer) pruneEnvRevisions(ctx context.Context, namespaceName string, serviceName string, revision int64) {
+	existing, err := s.listEnvRevisions(ctx, namespaceName, serviceName)
+	if err != nil {
+		s.logger.Error(err, "failed to list env revisions", "namespaceName", namespaceName, "serviceName", serviceName)
+		return
+	}
+
+	for idx := range existing {
+		if revisionNumber(&existing[idx].ObjectMeta) > revision-envRevisionHistoryLimit {
+			break
+		}
+		err = s.k8sClient.CoreV1().Secrets(namespaceName).Delete(ctx, existing[idx].Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			s.logger.Error(err, "failed to prune env revision", "namespaceName", namespaceName, "name", existing[idx].Name)
+		}
+	}
+}
+
+// latestEnvRevision returns the number of the current env revision of a service.
+// Pending env revisions aren't current yet.
+// It's zero if the env of the service was never changed.
+func (s *CliServer) latestEnvRevision(ctx context.Context, namespaceName string, serviceName string) (int64, error) {
+	revisions, err := s.listEnvRevisions(ctx, namespaceName, serviceName)
+	if err != nil {
+		return 0, err
+	}
+	for idx := len(revisions) - 1; idx >= 0; idx-- {
+		if !isPending(&revisions[idx].ObjectMeta) {
+			return revisionNumber(&revisions[idx].ObjectMeta), nil
+		}
+	}
+	return 0, nil
+}
+
+func isPending(meta *metav1.ObjectMeta) bool {
+	_, ok := meta.Labels[labelPending]
+	return ok
+}
+
+// listEnvRevisions returns all env revisions of a service ordered by revision number, pending ones included.
+func (s *CliServer) listEnvRevisions(ctx context.Context, namespaceName string, serviceName string) ([]corev1.Secret, error) {
+	list, err := s.k8sClient.CoreV1().Secrets(namespaceName).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(serviceLabels(serviceName, componentEnvRevision)).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := list.Items
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisionNumber(&revisions[i].ObjectMeta) < revisionNumber(&revisions[j].ObjectMeta)
+	})
+	return revisions, nil
+}
+
+type envChange struct {
+	Key      string `json:"key"`
+	Type     string `json:"type"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+	Secret   bool   `json:"secret,omitempty"`
+}
+
+// diffEnv lists the differences between two envs ordered by key.
+// Secret values never make it into a diff.
+func diffEnv(oldEnv map[string]string, oldSecretEnv map[string]string, newEnv map[string]string, newSecretEnv map[string]string) []envChange {
+	type envValue struct {
+		value  string
+		secret bool
+	}
+	merge := func(env map[string]string, secretEnv map[string]string) map[string]envValue {
+		merged := make(map[string]envValue, len(env)+len(secretEnv))
+		for key, value := range env {
+			merged[key] = envValue{value: value}
+		}
+		for key, value := range secretEnv {
+			merged[key] = envValue{value: value, secret: true}
+		}
+		return merged
+	}
+	display := func(v envValue) string {
+		if v.secret {
+			return maskedValue
+		}
+		return v.value
+	}
+
+	oldValues := merge(oldEnv, oldSecretEnv)
+	newValues := merge(newEnv, newSecretEnv)
+	changes := []envChange{}
+	for key, oldValue := range oldValues {
+		newValue, ok := newValues[key]
+		if !ok {
+			changes = append(changes, envChange{Key: key, Type: pb.EnvChange_REMOVED.String(), OldValue: display(oldValue), Secret: oldValue.secret})
+		} else if oldValue != newValue {
+			changes = append(changes, envChange{Key: key, Type: pb.EnvChange_CHANGED.String(), OldValue: display(oldValue), NewValue: display(newValue), Secret: newValue.secret})
+		}
+	}
+	for key, newValue := range newValues {
+		if _, ok := oldValues[key]; !ok {
+			changes = append(changes, envChange{Key: key, Type: pb.EnvChange_ADDED.String(), NewValue: display(newValue), Secret: newValue.secret})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
+
+func toEnvRevision(secret *corev1.Secret) (*pb.EnvRevision, error) {
+	var changes []envChange
+	err := json.Unmarshal(secret.Data[snapshotDiffKey], &changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EnvRevision{
+		Revision:  revisionNumber(&secret.ObjectMeta),
+		Author:    secret.Annotations[annotationChangedBy],
+		RequestID: secret.Annotations[annotationRequestID],
+		Timestamp: secret.CreationTimestamp.Unix(),
+		Changes:   toPbEnvChanges(changes),
+	}, nil
+}
+
+func toPbEnvChanges(changes []envChange) []*pb.EnvChange {
+	pbChanges := make([]*pb.EnvChange, len(changes))
+	for idx, change := range changes {
+		pbChanges[idx] = &pb.EnvChange{
+			Key:      change.Key,
+			Type:     pb.EnvChange_ChangeType(pb.EnvChange_ChangeType_value[change.Type]),
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+			Secret:   change.Secret,
+		}
+	}
+	return pbChanges
+}
+
+func toEnvSnapshot(env map[string]string, secretEnv map[string]string) map[string][]byte {
+	snapshot := make(map[string][]byte, len(env)+len(secretEnv))
+	for key, value := range env {
+		snapshot[snapshotPlainPrefix+key] = []byte(value)
+	}
+	for key, value := range secretEnv {
+		snapshot[snapshotSecretPrefix+key] = []byte(value)
+	}
+	return snapshot
+}
+
+func fromEnvSnapshot(snapshot map[string][]byte) (map[string]string, map[string]string) {
+	env := map[string]string{}
+	secretEnv := map[string]string{}
+	for key, value := range snapshot {
+		if strings.HasPrefix(key, snapshotPlainPrefix) {
+			env[strings.TrimPrefix(key, snapshotPlainPrefix)] = string(value)
+		} else if strings.HasPrefix(key, snapshotSecretPrefix) {
+			secretEnv[strings.TrimPrefix(key, snapshotSecretPrefix)] = string(value)
+		}
+	}
+	return env, secretEnv
+}
+
+func envRevisionName(serviceName string, revision int64) string {
+	return fmt.Sprintf("haiku-env-%s-r%d", serviceName, revision)
+}
+
+// revisionNumber reads the revision label of an object.
+// Objects without a (valid) revision label sort first.
+func revisionNumber(meta *metav1.ObjectMeta) int64 {
+	revision, err := strconv.ParseInt(meta.Labels[labelRevision], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
@@ -0,0 +1,402 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
+)
+
+// ListEnvironments returns all environments created through Init ordered by name.
+func (s *CliServer) ListEnvironments(ctx context.Context, req *pb.ListEnvironmentsRequest) (*pb.ListEnvironmentsReply, error) {
+	logger := s.logger.WithValues("requestID", requestid.FromContext(ctx))
+	logger.Info("list environments")
+	list, err := s.k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: environmentSelector,
+	})
+	if err != nil {
+		logger.Error(err, "failed to list namespaces")
+		return nil, err
+	}
+
+	environments := make([]*pb.Environment, len(list.Items))
+	for idx := range list.Items {
+		environments[idx] = toEnvironment(&list.Items[idx])
+	}
+	sort.Slice(environments, func(i, j int) bool {
+		return environments[i].Name < environments[j].Name
+	})
+
+	return &pb.ListEnvironmentsReply{
+		Environments: environments,
+	}, nil
+}
+
+// DescribeEnvironment gives an overview of everything that lives in an environment.
+func (s *CliServer) DescribeEnvironment(ctx context.Context, req *pb.DescribeEnvironmentRequest) (*pb.DescribeEnvironmentReply, error) {
+	namespace, err := s.getEnvironment(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespace.Name, "requestID", requestid.FromContext(ctx))
+	logger.Info("describe environment")
+
+	services, err := s.listServices(ctx, namespace.Name)
+	if err != nil {
+		logger.Error(err, "failed to list services")
+		return nil, err
+	}
+
+	dockerLogins, err := s.haikuClient.EntitiesV1alpha1().DockerLogins(namespace.Name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Error(err, "failed to list dockerlogins")
+		return nil, err
+	}
+
+	registryLogins := make([]*pb.RegistryLogin, len(dockerLogins.Items))
+	for idx, dl := range dockerLogins.Items {
+		registryLogins[idx] = &pb.RegistryLogin{
+			ID:       string(dl.UID),
+			Name:     dl.Name,
+			Server:   dl.Spec.Server,
+			Username: dl.Spec.Username,
+		}
+	}
+
+	envCounts, secretEnvCounts, err := s.countEnv(ctx, namespace.Name)
+	if err != nil {
+		logger.Error(err, "failed to count env")
+		return nil, err
+	}
+
+	usage, err := s.resourceUsage(ctx, namespace.Name)
+	if err != nil {
+		logger.Error(err, "failed to compute resource usage")
+		return nil, err
+	}
+
+	return &pb.DescribeEnvironmentReply{
+		Environment:     toEnvironment(namespace),
+		Services:        services,
+		RegistryLogins:  registryLogins,
+		EnvCounts:       envCounts,
+		SecretEnvCounts: secretEnvCounts,
+		Usage:           usage,
+	}, nil
+}
+
+// DeleteEnvironment tears down an environment and everything in it.
+// Since there's no coming back from this, the caller needs to prove they know which environment
+// they're deleting by passing its ID as confirmation token.
+// Services and docker logins are deleted first to give the operator a chance to clean up after them,
+// then the namespace goes and the call returns once it's gone for good.
+func (s *CliServer) DeleteEnvironment(ctx context.Context, req *pb.DeleteEnvironmentRequest) (*pb.DeleteEnvironmentReply, error) {
+	namespace, err := s.getEnvironment(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespace.Name, "requestID", requestid.FromContext(ctx))
+	logger.Info("delete environment")
+
+	if req.ConfirmationToken != string(namespace.UID) {
+		logger.Info("confirmation token doesn't match")
+		return nil, fmt.Errorf("%w: the confirmation token needs to be the ID of environment %s", ErrInvalidArgument, req.EnvironmentName)
+	}
+
+	err = s.teardownEnvironment(ctx, namespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteEnvironmentReply{
+		Success: true,
+	}, nil
+}
+
+// teardownEnvironment deletes everything in a namespace and the namespace itself
+// and waits for the namespace to be gone.
+func (s *CliServer) teardownEnvironment(ctx context.Context, namespace *corev1.Namespace, logger logr.Logger) error {
+	err := s.haikuClient.ServingV1alpha1().Services(namespace.Name).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{})
+	if err != nil {
+		logger.Error(err, "failed to delete services")
+		return err
+	}
+
+	err = s.haikuClient.EntitiesV1alpha1().DockerLogins(namespace.Name).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{})
+	if err != nil {
+		logger.Error(err, "failed to delete dockerlogins")
+		return err
+	}
+
+	// the namespace is only deleted as it was looked at, nobody changed it (e.g. extended it) since
+	propagation := metav1.DeletePropagationForeground
+	err = s.k8sClient.CoreV1().Namespaces().Delete(ctx, namespace.Name, metav1.DeleteOptions{
+		Preconditions:     &metav1.Preconditions{ResourceVersion: &namespace.ResourceVersion},
+		PropagationPolicy: &propagation,
+	})
+	if err != nil && errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		logger.Error(err, "failed to delete namespace")
+		return err
+	}
+
+	err = s.waitForNamespaceDeleted(ctx, namespace)
+	if err != nil {
+		logger.Error(err, "failed to wait for namespace termination")
+		return err
+	}
+	logger.Info("environment deleted")
+	return nil
+}
+
+// waitForNamespaceDeleted blocks until a namespace is gone.
+// Namespaces stay around (terminating) until everything in them is deleted.
+func (s *CliServer) waitForNamespaceDeleted(ctx context.Context, namespace *corev1.Namespace) error {
+	watcher, err := s.k8sClient.CoreV1().Namespaces().Watch(ctx, metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", namespace.Name).String(),
+		ResourceVersion: namespace.ResourceVersion,
+	})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.Error(codes.DeadlineExceeded, fmt.Sprintf("namespace %s is still terminating", namespace.Name))
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				// the watch might have timed out, see whether the namespace is gone in the meantime
+				_, err := s.k8sClient.CoreV1().Namespaces().Get(ctx, namespace.Name, metav1.GetOptions{})
+				if err != nil && errors.IsNotFound(err) {
+					return nil
+				} else if err != nil {
+					return err
+				}
+				return status.Error(codes.Unavailable, fmt.Sprintf("lost track of namespace %s while it was terminating", namespace.Name))
+			}
+			if event.Type == watch.Deleted {
+				return nil
+			}
+		}
+	}
+}
+
+// getEnvironment returns the namespace of an environment.
+// Environments are found through their label. They live in the namespace named after them with the prefix,
+// except for environments that were created before the prefix, those live in a namespace named exactly like them.
+// Namespaces that weren't created through Init (or adopted) aren't environments, even if their name suggests so.
+func (s *CliServer) getEnvironment(ctx context.Context, environmentName string) (*corev1.Namespace, error) {
+	namespaceName, err := getK8sNamespaceForHaikuSpaceName(environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := s.k8sClient.CoreV1().Namespaces().Get(ctx, namespaceName, metav1.GetOptions{})
+	if err == nil && isEnvironment(namespace) && namespace.Labels[labelEnvironment] == environmentName {
+		return namespace, nil
+	} else if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	list, err := s.k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(environmentLabels(environmentName)).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for idx := range list.Items {
+		if isEnvironment(&list.Items[idx]) {
+			return &list.Items[idx], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: environment %s", ErrNotFound, environmentName)
+}
+
+// environmentNamespace maps the name of an environment to its namespace.
+// Unlike getK8sNamespaceForHaikuSpaceName, it makes sure the environment exists and is managed by haiku.
+func (s *CliServer) environmentNamespace(ctx context.Context, environmentName string) (string, error) {
+	namespace, err := s.getEnvironment(ctx, environmentName)
+	if err != nil {
+		return "", err
+	}
+	return namespace.Name, nil
+}
+
+// AdoptLegacyEnvironments labels the namespaces of environments that were created before environments were labelled.
+// Back then, the namespace of an environment was named exactly like the environment.
+// A namespace is adopted if nobody else manages it and it holds haiku services or registry logins,
+// the environment keeps its name and namespace.
+// It's meant to run once when the server starts, failures are logged.
+func (s *CliServer) AdoptLegacyEnvironments(ctx context.Context) {
+	ctx = requestid.NewContext(ctx)
+	logger := s.logger.WithValues("requestID", requestid.FromContext(ctx))
+	list, err := s.k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Error(err, "failed to list namespaces")
+		return
+	}
+
+	for idx := range list.Items {
+		namespace := &list.Items[idx]
+		if _, ok := namespace.Labels[labelManagedBy]; ok || namespace.Status.Phase == corev1.NamespaceTerminating {
+			continue
+		}
+
+		logger := logger.WithValues("namespaceName", namespace.Name)
+		owned, err := s.holdsHaikuObjects(ctx, namespace.Name)
+		if err != nil {
+			logger.Error(err, "failed to look into namespace")
+			continue
+		} else if !owned {
+			continue
+		}
+
+		// the environment needs to be reachable under its name
+		environmentName := namespace.Name
+		if _, err := getK8sNamespaceForHaikuSpaceName(environmentName); err != nil {
+			logger.Info("can't adopt legacy environment", "reason", err.Error())
+			continue
+		}
+
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			current, err := s.k8sClient.CoreV1().Namespaces().Get(ctx, namespace.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			} else if _, ok := current.Labels[labelManagedBy]; ok {
+				return nil
+			}
+			if current.Labels == nil {
+				current.Labels = map[string]string{}
+			}
+			for key, value := range environmentLabels(environmentName) {
+				current.Labels[key] = value
+			}
+			_, err = s.k8sClient.CoreV1().Namespaces().Update(ctx, current, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			logger.Error(err, "failed to adopt legacy environment")
+			continue
+		}
+		logger.Info("adopted legacy environment", "environmentName", environmentName)
+	}
+}
+
+// holdsHaikuObjects tells whether there are haiku services or registry logins in a namespace.
+func (s *CliServer) holdsHaikuObjects(ctx context.Context, namespaceName string) (bool, error) {
+	services, err := s.haikuClient.ServingV1alpha1().Services(namespaceName).List(ctx, metav1.ListOptions{
+		Limit: 1,
+	})
+	if err != nil {
+		return false, err
+	} else if len(services.Items) > 0 {
+		return true, nil
+	}
+
+	dockerLogins, err := s.haikuClient.EntitiesV1alpha1().DockerLogins(namespaceName).List(ctx, metav1.ListOptions{
+		Limit: 1,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(dockerLogins.Items) > 0, nil
+}
+
+// countEnv returns the number of env vars and secret env vars per service.
+func (s *CliServer) countEnv(ctx context.Context, namespaceName string) (map[string]int32, map[string]int32, error) {
+	selector := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			labelManagedBy: managedByHaikuAPI,
+			labelComponent: componentEnv,
+		}).String(),
+	}
+
+	configMaps, err := s.k8sClient.CoreV1().ConfigMaps(namespaceName).List(ctx, selector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	envCounts := map[string]int32{}
+	for _, cm := range configMaps.Items {
+		envCounts[cm.Labels[labelService]] += int32(len(cm.Data))
+	}
+
+	secrets, err := s.k8sClient.CoreV1().Secrets(namespaceName).List(ctx, selector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secretEnvCounts := map[string]int32{}
+	for _, secret := range secrets.Items {
+		secretEnvCounts[secret.Labels[labelService]] += int32(len(secret.Data))
+	}
+	return envCounts, secretEnvCounts, nil
+}
+
+// resourceUsage adds up the requests of all pods that are still running or about to.
+func (s *CliServer) resourceUsage(ctx context.Context, namespaceName string) (*pb.ResourceUsage, error) {
+	pods, err := s.k8sClient.CoreV1().Pods(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &pb.ResourceUsage{}
+	cpu := resource.Quantity{}
+	memory := resource.Quantity{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		usage.Pods++
+		for _, container := range pod.Spec.Containers {
+			cpu.Add(*container.Resources.Requests.Cpu())
+			memory.Add(*container.Resources.Requests.Memory())
+		}
+	}
+	usage.CPURequests = cpu.String()
+	usage.MemoryRequests = memory.String()
+
+	quotas, err := s.k8sClient.CoreV1().ResourceQuotas(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, quota := range quotas.Items {
+		if hard, ok := quota.Spec.Hard[corev1.ResourceRequestsCPU]; ok {
+			usage.CPUQuota = hard.String()
+		}
+		if hard, ok := quota.Spec.Hard[corev1.ResourceRequestsMemory]; ok {
+			usage.MemoryQuota = hard.String()
+		}
+	}
+	return usage, nil
+}
+
+func toEnvironment(namespace *corev1.Namespace) *pb.Environment {
+	environment := &pb.Environment{
+		ID:        string(namespace.UID),
+		Name:      namespace.Labels[labelEnvironment],
+		CreatedAt: namespace.CreationTimestamp.Unix(),
+		Status:    string(namespace.Status.Phase),
+		Tier:      namespace.Labels[labelTier],
+	}
+	if t, ok := namespaceExpiry(namespace); ok {
+		environment.ExpiresAt = t.Unix()
+	}
+	return environment
+}
@@ -5,13 +5,25 @@ import "errors"
 type ErrorReason string
 
 const (
-	reasonAlreadyExists = "entity already exists"
+	reasonAlreadyExists   = "entity already exists"
+	reasonNotFound        = "entity not found"
+	reasonInvalidArgument = "invalid argument"
 )
 
 var (
-	ErrAlreadyExists = errors.New(reasonAlreadyExists)
+	ErrAlreadyExists   = errors.New(reasonAlreadyExists)
+	ErrNotFound        = errors.New(reasonNotFound)
+	ErrInvalidArgument = errors.New(reasonInvalidArgument)
 )
 
 func IsAlreadyExists(err error) bool {
 	return errors.Is(err, ErrAlreadyExists)
 }
+
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+func IsInvalidArgument(err error) bool {
+	return errors.Is(err, ErrInvalidArgument)
+}
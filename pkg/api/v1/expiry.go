@@ -0,0 +1,240 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// how long the reaper waits for a single environment to be torn down
+	reapTimeout = 5 * time.Minute
+	// the longest a ttl can be, longer ones are cut down to this
+	maxTTL = 10 * 365 * 24 * time.Hour
+
+	reasonEnvironmentExpired = "EnvironmentExpired"
+	reasonReapFailed         = "ReapFailed"
+)
+
+// ExtendEnvironmentTTL pushes back the expiry of an environment.
+func (s *CliServer) ExtendEnvironmentTTL(ctx context.Context, req *pb.ExtendEnvironmentTTLRequest) (*pb.ExtendEnvironmentTTLReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("extend environment ttl", "ttlSeconds", req.TTLSeconds)
+	if req.TTLSeconds <= 0 {
+		return nil, fmt.Errorf("%w: ttl needs to be positive", ErrInvalidArgument)
+	}
+
+	var namespace *corev1.Namespace
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespace, err = s.getEnvironment(ctx, req.EnvironmentName)
+		if err != nil {
+			return err
+		}
+
+		expiresAt, ok := namespaceExpiry(namespace)
+		if !ok {
+			return fmt.Errorf("%w: environment %s doesn't expire", ErrInvalidArgument, req.EnvironmentName)
+		} else if _, ok := namespace.Annotations[annotationReapedAt]; ok {
+			return status.Errorf(codes.FailedPrecondition, "environment %s expired and is being deleted", req.EnvironmentName)
+		}
+		if expiresAt.Before(time.Now()) {
+			expiresAt = time.Now()
+		}
+
+		setAnnotations(&namespace.ObjectMeta, map[string]string{
+			annotationExpiresAt: formatExpiry(expiresAt.Add(ttlDuration(req.TTLSeconds))),
+		})
+		namespace, err = s.k8sClient.CoreV1().Namespaces().Update(ctx, namespace, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil && IsNotFound(err) {
+		logger.Info("environment doesn't exist")
+		return nil, err
+	} else if err != nil {
+		logger.Error(err, "failed to extend ttl")
+		return nil, err
+	}
+
+	return &pb.ExtendEnvironmentTTLReply{
+		Environment: toEnvironment(namespace),
+	}, nil
+}
+
+// ReapExpiredEnvironments deletes expired environments every interval until the context is done.
+// It's meant to run in the background for as long as the server is up.
+func (s *CliServer) ReapExpiredEnvironments(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.reapExpiredEnvironments(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *CliServer) reapExpiredEnvironments(ctx context.Context) {
+	// every round gets a request id of its own so that its logs and events can be told apart
+	ctx = requestid.NewContext(ctx)
+	logger := s.logger.WithValues("requestID", requestid.FromContext(ctx))
+	list, err := s.k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: environmentSelector,
+	})
+	if err != nil {
+		logger.Error(err, "failed to list namespaces")
+		return
+	}
+
+	now := time.Now()
+	for idx := range list.Items {
+		namespace := &list.Items[idx]
+		if !isExpired(namespace, now) {
+			continue
+		}
+
+		logger := logger.WithValues("namespaceName", namespace.Name)
+		namespace, err := s.claimExpiredEnvironment(ctx, namespace.Name)
+		if err != nil && (errors.IsConflict(err) || errors.IsNotFound(err)) {
+			// the environment changed in the meantime, the next round has another look
+			continue
+		} else if err != nil {
+			logger.Error(err, "failed to claim expired environment")
+			continue
+		} else if namespace == nil {
+			// the environment was extended in the meantime
+			continue
+		}
+
+		expiresAt, _ := namespaceExpiry(namespace)
+		logger.Info("environment expired", "expiresAt", expiresAt)
+		s.recordNamespaceEvent(ctx, namespace, corev1.EventTypeNormal, reasonEnvironmentExpired, fmt.Sprintf("environment %s expired at %s and is being deleted", namespace.Labels[labelEnvironment], formatExpiry(expiresAt)))
+
+		teardownCtx, cancel := context.WithTimeout(ctx, reapTimeout)
+		err = s.teardownEnvironment(teardownCtx, namespace, logger)
+		cancel()
+		if err != nil {
+			s.recordNamespaceEvent(ctx, namespace, corev1.EventTypeWarning, reasonReapFailed, fmt.Sprintf("failed to delete expired environment %s: %s", namespace.Labels[labelEnvironment], err.Error()))
+		}
+	}
+}
+
+// claimExpiredEnvironment marks an environment as being reaped.
+// The namespace is looked at again right before, the list it came from might be stale.
+// The update is conditional on the version that was looked at,
+// so an environment that is extended concurrently either isn't claimed or can't be extended anymore.
+// Nothing is claimed (and nil returned) if the environment didn't expire after all.
+func (s *CliServer) claimExpiredEnvironment(ctx context.Context, namespaceName string) (*corev1.Namespace, error) {
+	namespace, err := s.k8sClient.CoreV1().Namespaces().Get(ctx, namespaceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	} else if !isExpired(namespace, time.Now()) {
+		return nil, nil
+	}
+
+	setAnnotations(&namespace.ObjectMeta, map[string]string{
+		annotationReapedAt:  formatExpiry(time.Now()),
+		annotationRequestID: requestid.FromContext(ctx),
+	})
+	return s.k8sClient.CoreV1().Namespaces().Update(ctx, namespace, metav1.UpdateOptions{})
+}
+
+// isExpired tells whether a namespace is an environment that is due to be reaped.
+func isExpired(namespace *corev1.Namespace, now time.Time) bool {
+	expiresAt, ok := namespaceExpiry(namespace)
+	return ok && !expiresAt.After(now) && isEnvironment(namespace) && namespace.Status.Phase != corev1.NamespaceTerminating
+}
+
+// recordNamespaceEvent leaves a k8s event behind for a namespace.
+// Events of cluster scoped objects live in the default namespace, that way they outlive the namespace.
+// Events are best effort, failing to record one is only logged.
+func (s *CliServer) recordNamespaceEvent(ctx context.Context, namespace *corev1.Namespace, eventType string, reason string, message string) {
+	now := metav1.Now()
+	_, err := s.k8sClient.CoreV1().Events(metav1.NamespaceDefault).Create(ctx, &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: namespace.Name + ".",
+			Namespace:    metav1.NamespaceDefault,
+			Annotations: map[string]string{
+				annotationRequestID: requestid.FromContext(ctx),
+			},
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      "v1",
+			Kind:            "Namespace",
+			Name:            namespace.Name,
+			UID:             namespace.UID,
+			ResourceVersion: namespace.ResourceVersion,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source: corev1.EventSource{
+			Component: managedByHaikuAPI,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		s.logger.Error(err, "failed to record event", "namespaceName", namespace.Name, "reason", reason)
+	}
+}
+
+// expiryFromRequest works out when a new environment expires.
+// The zero time means it doesn't.
+func expiryFromRequest(req *pb.InitRequest) (time.Time, error) {
+	switch {
+	case req.TTLSeconds < 0 || req.ExpiresAt < 0:
+		return time.Time{}, fmt.Errorf("%w: ttl and expiry can't be negative", ErrInvalidArgument)
+	case req.TTLSeconds > 0 && req.ExpiresAt > 0:
+		return time.Time{}, fmt.Errorf("%w: either set a ttl or an expiry but not both", ErrInvalidArgument)
+	case req.TTLSeconds > 0:
+		return time.Now().Add(ttlDuration(req.TTLSeconds)), nil
+	case req.ExpiresAt > 0:
+		expiresAt := time.Unix(req.ExpiresAt, 0)
+		if expiresAt.Before(time.Now()) {
+			return time.Time{}, fmt.Errorf("%w: expiry is in the past", ErrInvalidArgument)
+		}
+		return expiresAt, nil
+	}
+	return time.Time{}, nil
+}
+
+func namespaceExpiry(namespace *corev1.Namespace) (time.Time, bool) {
+	value, ok := namespace.Annotations[annotationExpiresAt]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ttlDuration turns a ttl in seconds into a duration.
+// Ttls are cut down to maxTTL, larger ones would overflow.
+func ttlDuration(seconds int64) time.Duration {
+	if seconds > int64(maxTTL/time.Second) {
+		return maxTTL
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func formatExpiry(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
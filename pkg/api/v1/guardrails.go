@@ -0,0 +1,251 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// names of the objects that keep an environment in check
+const (
+	guardrailQuotaName         = "haiku-quota"
+	guardrailLimitRangeName    = "haiku-limits"
+	guardrailNetworkPolicyName = "haiku-default-deny"
+)
+
+// how long deleting an environment whose guardrails couldn't be applied may take
+const guardrailCleanupTimeout = 30 * time.Second
+
+// Requests to services come in through the gateway, knative's activator sits in between when a service scales from zero.
+var gatewayNamespaces = []string{"kourier-system", "knative-serving"}
+
+// tier bundles the limits of an environment.
+// The quota caps the environment as a whole, the container limits cap a single instance of a service
+// and the defaults apply to services that don't ask for anything in particular.
+type tier struct {
+	Pods                   string
+	CPU                    string
+	Memory                 string
+	ContainerMaxCPU        string
+	ContainerMaxMemory     string
+	ContainerDefaultCPU    string
+	ContainerDefaultMemory string
+}
+
+var tiers = map[string]tier{
+	"small": {
+		Pods:                   "10",
+		CPU:                    "2",
+		Memory:                 "4Gi",
+		ContainerMaxCPU:        "1",
+		ContainerMaxMemory:     "1Gi",
+		ContainerDefaultCPU:    "100m",
+		ContainerDefaultMemory: "128Mi",
+	},
+	"medium": {
+		Pods:                   "30",
+		CPU:                    "8",
+		Memory:                 "16Gi",
+		ContainerMaxCPU:        "2",
+		ContainerMaxMemory:     "4Gi",
+		ContainerDefaultCPU:    "250m",
+		ContainerDefaultMemory: "256Mi",
+	},
+	"large": {
+		Pods:                   "100",
+		CPU:                    "32",
+		Memory:                 "64Gi",
+		ContainerMaxCPU:        "4",
+		ContainerMaxMemory:     "8Gi",
+		ContainerDefaultCPU:    "500m",
+		ContainerDefaultMemory: "512Mi",
+	},
+}
+
+// SetEnvironmentTier moves an environment to a different tier.
+// Running services keep running, the new limits apply to whatever is scheduled next.
+func (s *CliServer) SetEnvironmentTier(ctx context.Context, req *pb.SetEnvironmentTierRequest) (*pb.SetEnvironmentTierReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("set environment tier", "tier", req.Tier)
+	if _, ok := tiers[req.Tier]; !ok {
+		return nil, fmt.Errorf("%w: unknown tier %s", ErrInvalidArgument, req.Tier)
+	}
+
+	err = s.applyGuardrails(ctx, namespaceName, req.Tier)
+	if err != nil {
+		logger.Error(err, "failed to apply guardrails")
+		return nil, err
+	}
+
+	var namespace *corev1.Namespace
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespace, err = s.k8sClient.CoreV1().Namespaces().Get(ctx, namespaceName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		namespace.Labels[labelTier] = req.Tier
+		namespace, err = s.k8sClient.CoreV1().Namespaces().Update(ctx, namespace, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		logger.Error(err, "failed to update namespace")
+		return nil, err
+	}
+
+	return &pb.SetEnvironmentTierReply{
+		Environment: toEnvironment(namespace),
+	}, nil
+}
+
+// applyGuardrails creates or updates the quota, limit range and network policy of a tier in a namespace.
+func (s *CliServer) applyGuardrails(ctx context.Context, namespaceName string, tierName string) error {
+	t, ok := tiers[tierName]
+	if !ok {
+		return fmt.Errorf("%w: unknown tier %s", ErrInvalidArgument, tierName)
+	}
+
+	err := s.applyResourceQuota(ctx, namespaceName, corev1.ResourceQuotaSpec{
+		Hard: corev1.ResourceList{
+			corev1.ResourcePods:           resource.MustParse(t.Pods),
+			corev1.ResourceRequestsCPU:    resource.MustParse(t.CPU),
+			corev1.ResourceRequestsMemory: resource.MustParse(t.Memory),
+			corev1.ResourceLimitsCPU:      resource.MustParse(t.CPU),
+			corev1.ResourceLimitsMemory:   resource.MustParse(t.Memory),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.applyLimitRange(ctx, namespaceName, corev1.LimitRangeSpec{
+		Limits: []corev1.LimitRangeItem{
+			{
+				Type: corev1.LimitTypeContainer,
+				Max: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(t.ContainerMaxCPU),
+					corev1.ResourceMemory: resource.MustParse(t.ContainerMaxMemory),
+				},
+				Default: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(t.ContainerDefaultCPU),
+					corev1.ResourceMemory: resource.MustParse(t.ContainerDefaultMemory),
+				},
+				DefaultRequest: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(t.ContainerDefaultCPU),
+					corev1.ResourceMemory: resource.MustParse(t.ContainerDefaultMemory),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.applyNetworkPolicy(ctx, namespaceName, defaultDenyNetworkPolicy())
+}
+
+func (s *CliServer) applyResourceQuota(ctx context.Context, namespaceName string, spec corev1.ResourceQuotaSpec) error {
+	quotas := s.k8sClient.CoreV1().ResourceQuotas(namespaceName)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		quota, err := quotas.Get(ctx, guardrailQuotaName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			_, err = quotas.Create(ctx, &corev1.ResourceQuota{
+				ObjectMeta: guardrailMeta(namespaceName, guardrailQuotaName),
+				Spec:       spec,
+			}, metav1.CreateOptions{})
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		quota.Spec = spec
+		_, err = quotas.Update(ctx, quota, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (s *CliServer) applyLimitRange(ctx context.Context, namespaceName string, spec corev1.LimitRangeSpec) error {
+	limitRanges := s.k8sClient.CoreV1().LimitRanges(namespaceName)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		limitRange, err := limitRanges.Get(ctx, guardrailLimitRangeName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			_, err = limitRanges.Create(ctx, &corev1.LimitRange{
+				ObjectMeta: guardrailMeta(namespaceName, guardrailLimitRangeName),
+				Spec:       spec,
+			}, metav1.CreateOptions{})
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		limitRange.Spec = spec
+		_, err = limitRanges.Update(ctx, limitRange, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (s *CliServer) applyNetworkPolicy(ctx context.Context, namespaceName string, spec networkingv1.NetworkPolicySpec) error {
+	networkPolicies := s.k8sClient.NetworkingV1().NetworkPolicies(namespaceName)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		networkPolicy, err := networkPolicies.Get(ctx, guardrailNetworkPolicyName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			_, err = networkPolicies.Create(ctx, &networkingv1.NetworkPolicy{
+				ObjectMeta: guardrailMeta(namespaceName, guardrailNetworkPolicyName),
+				Spec:       spec,
+			}, metav1.CreateOptions{})
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		networkPolicy.Spec = spec
+		_, err = networkPolicies.Update(ctx, networkPolicy, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// defaultDenyNetworkPolicy lets nothing into the pods of an environment unless it comes through the gateway.
+func defaultDenyNetworkPolicy() networkingv1.NetworkPolicySpec {
+	peers := make([]networkingv1.NetworkPolicyPeer, len(gatewayNamespaces))
+	for idx, namespaceName := range gatewayNamespaces {
+		peers[idx] = networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					corev1.LabelMetadataName: namespaceName,
+				},
+			},
+		}
+	}
+
+	return networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{
+			{
+				From: peers,
+			},
+		},
+	}
+}
+
+func guardrailMeta(namespaceName string, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace: namespaceName,
+		Name:      name,
+		Labels: map[string]string{
+			labelManagedBy: managedByHaikuAPI,
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const haikuPrefix = "haiku.io/"
+
+// Labels are put on every k8s object haiku-api creates on behalf of a service.
+// They allow us to find (and clean up) everything that belongs to a service.
+const (
+	labelManagedBy = "app.kubernetes.io/managed-by"
+	labelService   = "haiku.io/service"
+	labelComponent = "haiku.io/component"
+	labelRevision  = "haiku.io/revision"
+	// on namespaces only, holds the name of the environment
+	labelEnvironment = "haiku.io/environment"
+	labelTier        = "haiku.io/tier"
+	// on builds only, see the build phases
+	labelBuildPhase = "haiku.io/build-phase"
+	// on env revisions only, the env they describe isn't written (yet)
+	labelPending = "haiku.io/pending"
+
+	managedByHaikuAPI    = "haiku-api"
+	componentEnv         = "env"
+	componentEnvRevision = "env-revision"
+	// the immutable copy of an env revision a haiku service points at
+	componentEnvCopy  = "env-copy"
+	componentRevision = "revision"
+	componentBuild    = "build"
+)
+
+// Annotations on haiku services that tell who deployed what when.
+const (
+	annotationDeployedBy = "haiku.io/deployed-by"
+	annotationDeployedAt = "haiku.io/deployed-at"
+)
+
+// Annotations to keep track of who changed what.
+const (
+	annotationCreatedBy = "haiku.io/created-by"
+	annotationExpiresAt = "haiku.io/expires-at"
+	// when the reaper claimed an expired environment, it can't be extended anymore
+	annotationReapedAt  = "haiku.io/reaped-at"
+	annotationChangedBy = "haiku.io/changed-by"
+	annotationRequestID = "haiku.io/request-id"
+)
+
+// Annotations on builds.
+const (
+	// the key of the archive in object storage
+	annotationSourceKey = "haiku.io/source-key"
+	// where the image is pushed to, the build name is the tag
+	annotationBuildRepository = "haiku.io/build-repository"
+	annotationCancelledBy     = "haiku.io/cancelled-by"
+	// when the deploy of a build was claimed
+	annotationDeployStartedAt = "haiku.io/deploy-started-at"
+	// where the deployed build is serving
+	annotationBuildURL = "haiku.io/build-url"
+	// the revision of the service the build was deployed as
+	annotationBuildRevision = "haiku.io/build-revision"
+	// why the build (or its deploy) failed
+	annotationBuildMessage = "haiku.io/build-message"
+	// the service the build was started for, missing for builds of new services
+	annotationServiceUID = "haiku.io/service-uid"
+)
+
+// bookkeepingAnnotations are maintained by haiku-api itself.
+// Every other haiku annotation on a service is considered config and is part of a revision.
+var bookkeepingAnnotations = map[string]bool{
+	annotationDeployedBy: true,
+	annotationDeployedAt: true,
+	annotationRequestID:  true,
+}
+
+func configAnnotations(meta *metav1.ObjectMeta) map[string]string {
+	config := map[string]string{}
+	for key, value := range meta.Annotations {
+		if strings.HasPrefix(key, haikuPrefix) && !bookkeepingAnnotations[key] {
+			config[key] = value
+		}
+	}
+	return config
+}
+
+func serviceLabels(serviceName string, component string) map[string]string {
+	return map[string]string{
+		labelManagedBy: managedByHaikuAPI,
+		labelService:   serviceName,
+		labelComponent: component,
+	}
+}
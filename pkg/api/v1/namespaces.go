@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Every environment lives in a namespace of its own.
+// The prefix keeps environments from colliding with namespaces that have nothing to do with haiku.
+const namespacePrefix = "haiku-"
+
+// selects the namespaces of all environments
+var environmentSelector = labelManagedBy + "=" + managedByHaikuAPI + "," + labelEnvironment
+
+// Environment names that are taken by k8s itself or by components haiku runs on.
+// Some of them are harmless with the prefix but would be confusing nonetheless.
+var reservedEnvironmentNames = map[string]bool{
+	"default":          true,
+	"kube-system":      true,
+	"kube-public":      true,
+	"kube-node-lease":  true,
+	"system":           true,
+	"operator":         true,
+	"knative-serving":  true,
+	"kourier-system":   true,
+	"tekton-pipelines": true,
+}
+
+// getK8sNamespaceForHaikuSpaceName maps the name of an environment to the name of its namespace.
+// Environments adopted from before the prefix are the exception, getEnvironment finds those too.
+// It fails for names that can't or shouldn't be environments.
+func getK8sNamespaceForHaikuSpaceName(environmentName string) (string, error) {
+	if environmentName == "" {
+		return "", fmt.Errorf("%w: environment name is missing", ErrInvalidArgument)
+	}
+	if reservedEnvironmentNames[environmentName] {
+		return "", fmt.Errorf("%w: environment name %s is reserved", ErrInvalidArgument, environmentName)
+	}
+
+	namespaceName := namespacePrefix + environmentName
+	if errs := validation.IsDNS1123Label(namespaceName); len(errs) > 0 {
+		return "", fmt.Errorf("%w: environment name %s: %s", ErrInvalidArgument, environmentName, strings.Join(errs, ", "))
+	}
+	return namespaceName, nil
+}
+
+// environmentLabels mark a namespace as environment.
+// Only namespaces carrying them are considered environments, the prefix alone isn't enough.
+func environmentLabels(environmentName string) map[string]string {
+	return map[string]string{
+		labelManagedBy:   managedByHaikuAPI,
+		labelEnvironment: environmentName,
+	}
+}
+
+// isEnvironment tells whether a namespace is the one of an environment.
+// Environments that were adopted from before the prefix live in a namespace without it.
+func isEnvironment(namespace *corev1.Namespace) bool {
+	environmentName := namespace.Labels[labelEnvironment]
+	return namespace.Labels[labelManagedBy] == managedByHaikuAPI &&
+		environmentName != "" &&
+		(namespace.Name == namespacePrefix+environmentName || namespace.Name == environmentName)
+}
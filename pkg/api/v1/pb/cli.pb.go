@@ -20,6 +20,54 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MERGE adds and overwrites the imported keys and leaves everything else alone.
+// REPLACE makes the imported keys the only env vars of their kind (plain or secret).
+type ImportMode int32
+
+const (
+	ImportMode_MERGE   ImportMode = 0
+	ImportMode_REPLACE ImportMode = 1
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "MERGE",
+		1: "REPLACE",
+	}
+	ImportMode_value = map[string]int32{
+		"MERGE":   0,
+		"REPLACE": 1,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cli_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_cli_proto_enumTypes[0]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{0}
+}
+
 type UploadStatus int32
 
 const (
@@ -53,11 +101,11 @@ func (x UploadStatus) String() string {
 }
 
 func (UploadStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_cli_proto_enumTypes[0].Descriptor()
+	return file_cli_proto_enumTypes[1].Descriptor()
 }
 
 func (UploadStatus) Type() protoreflect.EnumType {
-	return &file_cli_proto_enumTypes[0]
+	return &file_cli_proto_enumTypes[1]
 }
 
 func (x UploadStatus) Number() protoreflect.EnumNumber {
@@ -66,7 +114,298 @@ func (x UploadStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UploadStatus.Descriptor instead.
 func (UploadStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cli_proto_rawDescGZIP(), []int{0}
+	return file_cli_proto_rawDescGZIP(), []int{1}
+}
+
+type DeployEvent_DeployEventType int32
+
+const (
+	DeployEvent_APPLIED           DeployEvent_DeployEventType = 0
+	DeployEvent_REVISION_CREATED  DeployEvent_DeployEventType = 1
+	DeployEvent_POD_SCHEDULED     DeployEvent_DeployEventType = 2
+	DeployEvent_IMAGE_PULLING     DeployEvent_DeployEventType = 3
+	DeployEvent_IMAGE_PULLED      DeployEvent_DeployEventType = 4
+	DeployEvent_CONTAINER_STARTED DeployEvent_DeployEventType = 5
+	DeployEvent_WARNING           DeployEvent_DeployEventType = 6
+	DeployEvent_READY             DeployEvent_DeployEventType = 7
+	DeployEvent_FAILED            DeployEvent_DeployEventType = 8
+)
+
+// Enum value maps for DeployEvent_DeployEventType.
+var (
+	DeployEvent_DeployEventType_name = map[int32]string{
+		0: "APPLIED",
+		1: "REVISION_CREATED",
+		2: "POD_SCHEDULED",
+		3: "IMAGE_PULLING",
+		4: "IMAGE_PULLED",
+		5: "CONTAINER_STARTED",
+		6: "WARNING",
+		7: "READY",
+		8: "FAILED",
+	}
+	DeployEvent_DeployEventType_value = map[string]int32{
+		"APPLIED":           0,
+		"REVISION_CREATED":  1,
+		"POD_SCHEDULED":     2,
+		"IMAGE_PULLING":     3,
+		"IMAGE_PULLED":      4,
+		"CONTAINER_STARTED": 5,
+		"WARNING":           6,
+		"READY":             7,
+		"FAILED":            8,
+	}
+)
+
+func (x DeployEvent_DeployEventType) Enum() *DeployEvent_DeployEventType {
+	p := new(DeployEvent_DeployEventType)
+	*p = x
+	return p
+}
+
+func (x DeployEvent_DeployEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeployEvent_DeployEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cli_proto_enumTypes[2].Descriptor()
+}
+
+func (DeployEvent_DeployEventType) Type() protoreflect.EnumType {
+	return &file_cli_proto_enumTypes[2]
+}
+
+func (x DeployEvent_DeployEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeployEvent_DeployEventType.Descriptor instead.
+func (DeployEvent_DeployEventType) EnumDescriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type RolloutUpdate_RolloutPhase int32
+
+const (
+	RolloutUpdate_DEPLOYED    RolloutUpdate_RolloutPhase = 0
+	RolloutUpdate_SHIFTED     RolloutUpdate_RolloutPhase = 1
+	RolloutUpdate_CHECKED     RolloutUpdate_RolloutPhase = 2
+	RolloutUpdate_PROMOTED    RolloutUpdate_RolloutPhase = 3
+	RolloutUpdate_ROLLED_BACK RolloutUpdate_RolloutPhase = 4
+)
+
+// Enum value maps for RolloutUpdate_RolloutPhase.
+var (
+	RolloutUpdate_RolloutPhase_name = map[int32]string{
+		0: "DEPLOYED",
+		1: "SHIFTED",
+		2: "CHECKED",
+		3: "PROMOTED",
+		4: "ROLLED_BACK",
+	}
+	RolloutUpdate_RolloutPhase_value = map[string]int32{
+		"DEPLOYED":    0,
+		"SHIFTED":     1,
+		"CHECKED":     2,
+		"PROMOTED":    3,
+		"ROLLED_BACK": 4,
+	}
+)
+
+func (x RolloutUpdate_RolloutPhase) Enum() *RolloutUpdate_RolloutPhase {
+	p := new(RolloutUpdate_RolloutPhase)
+	*p = x
+	return p
+}
+
+func (x RolloutUpdate_RolloutPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RolloutUpdate_RolloutPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_cli_proto_enumTypes[3].Descriptor()
+}
+
+func (RolloutUpdate_RolloutPhase) Type() protoreflect.EnumType {
+	return &file_cli_proto_enumTypes[3]
+}
+
+func (x RolloutUpdate_RolloutPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RolloutUpdate_RolloutPhase.Descriptor instead.
+func (RolloutUpdate_RolloutPhase) EnumDescriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{39, 0}
+}
+
+type EnvChange_ChangeType int32
+
+const (
+	EnvChange_ADDED   EnvChange_ChangeType = 0
+	EnvChange_CHANGED EnvChange_ChangeType = 1
+	EnvChange_REMOVED EnvChange_ChangeType = 2
+)
+
+// Enum value maps for EnvChange_ChangeType.
+var (
+	EnvChange_ChangeType_name = map[int32]string{
+		0: "ADDED",
+		1: "CHANGED",
+		2: "REMOVED",
+	}
+	EnvChange_ChangeType_value = map[string]int32{
+		"ADDED":   0,
+		"CHANGED": 1,
+		"REMOVED": 2,
+	}
+)
+
+func (x EnvChange_ChangeType) Enum() *EnvChange_ChangeType {
+	p := new(EnvChange_ChangeType)
+	*p = x
+	return p
+}
+
+func (x EnvChange_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnvChange_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cli_proto_enumTypes[4].Descriptor()
+}
+
+func (EnvChange_ChangeType) Type() protoreflect.EnumType {
+	return &file_cli_proto_enumTypes[4]
+}
+
+func (x EnvChange_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnvChange_ChangeType.Descriptor instead.
+func (EnvChange_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{62, 0}
+}
+
+type DeploymentUpdate_StageStatus int32
+
+const (
+	DeploymentUpdate_STARTED   DeploymentUpdate_StageStatus = 0
+	DeploymentUpdate_SUCCEEDED DeploymentUpdate_StageStatus = 1
+	DeploymentUpdate_FAILED    DeploymentUpdate_StageStatus = 2
+	// a line of build output, see Step and Stream
+	DeploymentUpdate_LOG DeploymentUpdate_StageStatus = 3
+	// the build was cancelled, nothing follows
+	DeploymentUpdate_CANCELLED DeploymentUpdate_StageStatus = 4
+)
+
+// Enum value maps for DeploymentUpdate_StageStatus.
+var (
+	DeploymentUpdate_StageStatus_name = map[int32]string{
+		0: "STARTED",
+		1: "SUCCEEDED",
+		2: "FAILED",
+		3: "LOG",
+		4: "CANCELLED",
+	}
+	DeploymentUpdate_StageStatus_value = map[string]int32{
+		"STARTED":   0,
+		"SUCCEEDED": 1,
+		"FAILED":    2,
+		"LOG":       3,
+		"CANCELLED": 4,
+	}
+)
+
+func (x DeploymentUpdate_StageStatus) Enum() *DeploymentUpdate_StageStatus {
+	p := new(DeploymentUpdate_StageStatus)
+	*p = x
+	return p
+}
+
+func (x DeploymentUpdate_StageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeploymentUpdate_StageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cli_proto_enumTypes[5].Descriptor()
+}
+
+func (DeploymentUpdate_StageStatus) Type() protoreflect.EnumType {
+	return &file_cli_proto_enumTypes[5]
+}
+
+func (x DeploymentUpdate_StageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeploymentUpdate_StageStatus.Descriptor instead.
+func (DeploymentUpdate_StageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{72, 0}
+}
+
+type Build_BuildStatus int32
+
+const (
+	Build_PENDING   Build_BuildStatus = 0
+	Build_RUNNING   Build_BuildStatus = 1
+	Build_SUCCEEDED Build_BuildStatus = 2
+	Build_FAILED    Build_BuildStatus = 3
+	Build_CANCELLED Build_BuildStatus = 4
+	// the image is pushed and is being deployed
+	Build_DEPLOYING Build_BuildStatus = 5
+	// the image is deployed, see URL and Revision
+	Build_DEPLOYED Build_BuildStatus = 6
+)
+
+// Enum value maps for Build_BuildStatus.
+var (
+	Build_BuildStatus_name = map[int32]string{
+		0: "PENDING",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+		4: "CANCELLED",
+		5: "DEPLOYING",
+		6: "DEPLOYED",
+	}
+	Build_BuildStatus_value = map[string]int32{
+		"PENDING":   0,
+		"RUNNING":   1,
+		"SUCCEEDED": 2,
+		"FAILED":    3,
+		"CANCELLED": 4,
+		"DEPLOYING": 5,
+		"DEPLOYED":  6,
+	}
+)
+
+func (x Build_BuildStatus) Enum() *Build_BuildStatus {
+	p := new(Build_BuildStatus)
+	*p = x
+	return p
+}
+
+func (x Build_BuildStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Build_BuildStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cli_proto_enumTypes[6].Descriptor()
+}
+
+func (Build_BuildStatus) Type() protoreflect.EnumType {
+	return &file_cli_proto_enumTypes[6]
+}
+
+func (x Build_BuildStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Build_BuildStatus.Descriptor instead.
+func (Build_BuildStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{81, 0}
 }
 
 type InitRequest struct {
@@ -75,6 +414,13 @@ type InitRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	// one of small, medium or large
+	// empty means no quota, limits or network policy
+	Tier string `protobuf:"bytes,2,opt,name=Tier,proto3" json:"Tier,omitempty"`
+	// the environment is deleted automatically after this many seconds
+	TTLSeconds int64 `protobuf:"varint,3,opt,name=TTLSeconds,proto3" json:"TTLSeconds,omitempty"`
+	// alternatively, the unix timestamp in seconds when the environment expires
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
 func (x *InitRequest) Reset() {
@@ -116,6 +462,27 @@ func (x *InitRequest) GetEnvironmentName() string {
 	return ""
 }
 
+func (x *InitRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *InitRequest) GetTTLSeconds() int64 {
+	if x != nil {
+		return x.TTLSeconds
+	}
+	return 0
+}
+
+func (x *InitRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
 type InitReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -163,18 +530,24 @@ func (x *InitReply) GetID() string {
 	return ""
 }
 
-type DeployRequest struct {
+type Environment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Image           string `protobuf:"bytes,1,opt,name=Image,proto3" json:"Image,omitempty"`
-	EnvironmentName string `protobuf:"bytes,2,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
-	ServiceName     string `protobuf:"bytes,3,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	ID   string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// unix timestamp in seconds
+	CreatedAt int64 `protobuf:"varint,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	// Active or Terminating
+	Status string `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`
+	Tier   string `protobuf:"bytes,5,opt,name=Tier,proto3" json:"Tier,omitempty"`
+	// unix timestamp in seconds, zero if the environment doesn't expire
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (x *DeployRequest) Reset() {
-	*x = DeployRequest{}
+func (x *Environment) Reset() {
+	*x = Environment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -182,13 +555,13 @@ func (x *DeployRequest) Reset() {
 	}
 }
 
-func (x *DeployRequest) String() string {
+func (x *Environment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployRequest) ProtoMessage() {}
+func (*Environment) ProtoMessage() {}
 
-func (x *DeployRequest) ProtoReflect() protoreflect.Message {
+func (x *Environment) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -200,43 +573,66 @@ func (x *DeployRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployRequest.ProtoReflect.Descriptor instead.
-func (*DeployRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Environment.ProtoReflect.Descriptor instead.
+func (*Environment) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DeployRequest) GetImage() string {
+func (x *Environment) GetID() string {
 	if x != nil {
-		return x.Image
+		return x.ID
 	}
 	return ""
 }
 
-func (x *DeployRequest) GetEnvironmentName() string {
+func (x *Environment) GetName() string {
 	if x != nil {
-		return x.EnvironmentName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DeployRequest) GetServiceName() string {
+func (x *Environment) GetCreatedAt() int64 {
 	if x != nil {
-		return x.ServiceName
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Environment) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type DeployReply struct {
+func (x *Environment) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *Environment) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RegistryLogin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID  string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	URL string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	ID       string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Server   string `protobuf:"bytes,3,opt,name=Server,proto3" json:"Server,omitempty"`
+	Username string `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
 }
 
-func (x *DeployReply) Reset() {
-	*x = DeployReply{}
+func (x *RegistryLogin) Reset() {
+	*x = RegistryLogin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,13 +640,13 @@ func (x *DeployReply) Reset() {
 	}
 }
 
-func (x *DeployReply) String() string {
+func (x *RegistryLogin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployReply) ProtoMessage() {}
+func (*RegistryLogin) ProtoMessage() {}
 
-func (x *DeployReply) ProtoReflect() protoreflect.Message {
+func (x *RegistryLogin) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -262,33 +658,55 @@ func (x *DeployReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployReply.ProtoReflect.Descriptor instead.
-func (*DeployReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryLogin.ProtoReflect.Descriptor instead.
+func (*RegistryLogin) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DeployReply) GetID() string {
+func (x *RegistryLogin) GetID() string {
 	if x != nil {
 		return x.ID
 	}
 	return ""
 }
 
-func (x *DeployReply) GetURL() string {
+func (x *RegistryLogin) GetName() string {
 	if x != nil {
-		return x.URL
+		return x.Name
 	}
 	return ""
 }
 
-type ListEnvRequest struct {
+func (x *RegistryLogin) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *RegistryLogin) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// What the pods of an environment request right now
+// and what they are allowed to request in total if there's a quota.
+type ResourceUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pods           int32  `protobuf:"varint,1,opt,name=Pods,proto3" json:"Pods,omitempty"`
+	CPURequests    string `protobuf:"bytes,2,opt,name=CPURequests,proto3" json:"CPURequests,omitempty"`
+	MemoryRequests string `protobuf:"bytes,3,opt,name=MemoryRequests,proto3" json:"MemoryRequests,omitempty"`
+	CPUQuota       string `protobuf:"bytes,4,opt,name=CPUQuota,proto3" json:"CPUQuota,omitempty"`
+	MemoryQuota    string `protobuf:"bytes,5,opt,name=MemoryQuota,proto3" json:"MemoryQuota,omitempty"`
 }
 
-func (x *ListEnvRequest) Reset() {
-	*x = ListEnvRequest{}
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,13 +714,13 @@ func (x *ListEnvRequest) Reset() {
 	}
 }
 
-func (x *ListEnvRequest) String() string {
+func (x *ResourceUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEnvRequest) ProtoMessage() {}
+func (*ResourceUsage) ProtoMessage() {}
 
-func (x *ListEnvRequest) ProtoReflect() protoreflect.Message {
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,35 +732,76 @@ func (x *ListEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEnvRequest.ProtoReflect.Descriptor instead.
-func (*ListEnvRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{4}
 }
 
-type ListEnvReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ResourceUsage) GetPods() int32 {
+	if x != nil {
+		return x.Pods
+	}
+	return 0
+}
 
-	List []*ListEnvReply_KeyValue `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty"`
+func (x *ResourceUsage) GetCPURequests() string {
+	if x != nil {
+		return x.CPURequests
+	}
+	return ""
 }
 
-func (x *ListEnvReply) Reset() {
-	*x = ListEnvReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ResourceUsage) GetMemoryRequests() string {
+	if x != nil {
+		return x.MemoryRequests
 	}
+	return ""
 }
 
-func (x *ListEnvReply) String() string {
+func (x *ResourceUsage) GetCPUQuota() string {
+	if x != nil {
+		return x.CPUQuota
+	}
+	return ""
+}
+
+func (x *ResourceUsage) GetMemoryQuota() string {
+	if x != nil {
+		return x.MemoryQuota
+	}
+	return ""
+}
+
+// The desired state of a service.
+type ServiceSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Image string            `protobuf:"bytes,2,opt,name=Image,proto3" json:"Image,omitempty"`
+	Env   map[string]string `protobuf:"bytes,3,rep,name=Env,proto3" json:"Env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// values might be given as "sha256:<hex>" hashes instead
+	SecretEnv map[string]string `protobuf:"bytes,4,rep,name=SecretEnv,proto3" json:"SecretEnv,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Settings  *ServiceSettings  `protobuf:"bytes,5,opt,name=Settings,proto3" json:"Settings,omitempty"`
+}
+
+func (x *ServiceSpec) Reset() {
+	*x = ServiceSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEnvReply) ProtoMessage() {}
+func (*ServiceSpec) ProtoMessage() {}
 
-func (x *ListEnvReply) ProtoReflect() protoreflect.Message {
+func (x *ServiceSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -354,32 +813,59 @@ func (x *ListEnvReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEnvReply.ProtoReflect.Descriptor instead.
-func (*ListEnvReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceSpec.ProtoReflect.Descriptor instead.
+func (*ServiceSpec) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListEnvReply) GetList() []*ListEnvReply_KeyValue {
+func (x *ServiceSpec) GetName() string {
 	if x != nil {
-		return x.List
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceSpec) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ServiceSpec) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
 	}
 	return nil
 }
 
-type SetEnvRequest struct {
+func (x *ServiceSpec) GetSecretEnv() map[string]string {
+	if x != nil {
+		return x.SecretEnv
+	}
+	return nil
+}
+
+func (x *ServiceSpec) GetSettings() *ServiceSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// The desired state of an environment.
+type EnvironmentSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key             string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	Value           string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
-	ProjectName     string `protobuf:"bytes,3,opt,name=ProjectName,proto3" json:"ProjectName,omitempty"`
-	ServiceName     string `protobuf:"bytes,4,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
-	EnvironmentName string `protobuf:"bytes,5,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	Services []*ServiceSpec `protobuf:"bytes,1,rep,name=Services,proto3" json:"Services,omitempty"`
+	// only Server and Username are considered
+	RegistryLogins []*RegistryLogin `protobuf:"bytes,2,rep,name=RegistryLogins,proto3" json:"RegistryLogins,omitempty"`
 }
 
-func (x *SetEnvRequest) Reset() {
-	*x = SetEnvRequest{}
+func (x *EnvironmentSpec) Reset() {
+	*x = EnvironmentSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -387,13 +873,13 @@ func (x *SetEnvRequest) Reset() {
 	}
 }
 
-func (x *SetEnvRequest) String() string {
+func (x *EnvironmentSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetEnvRequest) ProtoMessage() {}
+func (*EnvironmentSpec) ProtoMessage() {}
 
-func (x *SetEnvRequest) ProtoReflect() protoreflect.Message {
+func (x *EnvironmentSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,56 +891,33 @@ func (x *SetEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetEnvRequest.ProtoReflect.Descriptor instead.
-func (*SetEnvRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnvironmentSpec.ProtoReflect.Descriptor instead.
+func (*EnvironmentSpec) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SetEnvRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *SetEnvRequest) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-func (x *SetEnvRequest) GetProjectName() string {
-	if x != nil {
-		return x.ProjectName
-	}
-	return ""
-}
-
-func (x *SetEnvRequest) GetServiceName() string {
+func (x *EnvironmentSpec) GetServices() []*ServiceSpec {
 	if x != nil {
-		return x.ServiceName
+		return x.Services
 	}
-	return ""
+	return nil
 }
 
-func (x *SetEnvRequest) GetEnvironmentName() string {
+func (x *EnvironmentSpec) GetRegistryLogins() []*RegistryLogin {
 	if x != nil {
-		return x.EnvironmentName
+		return x.RegistryLogins
 	}
-	return ""
+	return nil
 }
 
-type SetEnvReply struct {
+type ListEnvironmentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
 }
 
-func (x *SetEnvReply) Reset() {
-	*x = SetEnvReply{}
+func (x *ListEnvironmentsRequest) Reset() {
+	*x = ListEnvironmentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,13 +925,13 @@ func (x *SetEnvReply) Reset() {
 	}
 }
 
-func (x *SetEnvReply) String() string {
+func (x *ListEnvironmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetEnvReply) ProtoMessage() {}
+func (*ListEnvironmentsRequest) ProtoMessage() {}
 
-func (x *SetEnvReply) ProtoReflect() protoreflect.Message {
+func (x *ListEnvironmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,28 +943,21 @@ func (x *SetEnvReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetEnvReply.ProtoReflect.Descriptor instead.
-func (*SetEnvReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEnvironmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnvironmentsRequest) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SetEnvReply) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-type RemoveEnvRequest struct {
+type ListEnvironmentsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Environments []*Environment `protobuf:"bytes,1,rep,name=Environments,proto3" json:"Environments,omitempty"`
 }
 
-func (x *RemoveEnvRequest) Reset() {
-	*x = RemoveEnvRequest{}
+func (x *ListEnvironmentsReply) Reset() {
+	*x = ListEnvironmentsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -509,13 +965,13 @@ func (x *RemoveEnvRequest) Reset() {
 	}
 }
 
-func (x *RemoveEnvRequest) String() string {
+func (x *ListEnvironmentsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEnvRequest) ProtoMessage() {}
+func (*ListEnvironmentsReply) ProtoMessage() {}
 
-func (x *RemoveEnvRequest) ProtoReflect() protoreflect.Message {
+func (x *ListEnvironmentsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,28 +983,28 @@ func (x *RemoveEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEnvRequest.ProtoReflect.Descriptor instead.
-func (*RemoveEnvRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEnvironmentsReply.ProtoReflect.Descriptor instead.
+func (*ListEnvironmentsReply) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RemoveEnvRequest) GetKey() string {
+func (x *ListEnvironmentsReply) GetEnvironments() []*Environment {
 	if x != nil {
-		return x.Key
+		return x.Environments
 	}
-	return ""
+	return nil
 }
 
-type RemoveEnvReply struct {
+type DescribeEnvironmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
 }
 
-func (x *RemoveEnvReply) Reset() {
-	*x = RemoveEnvReply{}
+func (x *DescribeEnvironmentRequest) Reset() {
+	*x = DescribeEnvironmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -556,13 +1012,13 @@ func (x *RemoveEnvReply) Reset() {
 	}
 }
 
-func (x *RemoveEnvReply) String() string {
+func (x *DescribeEnvironmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEnvReply) ProtoMessage() {}
+func (*DescribeEnvironmentRequest) ProtoMessage() {}
 
-func (x *RemoveEnvReply) ProtoReflect() protoreflect.Message {
+func (x *DescribeEnvironmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -574,33 +1030,34 @@ func (x *RemoveEnvReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEnvReply.ProtoReflect.Descriptor instead.
-func (*RemoveEnvReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeEnvironmentRequest.ProtoReflect.Descriptor instead.
+func (*DescribeEnvironmentRequest) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RemoveEnvReply) GetSuccess() bool {
+func (x *DescribeEnvironmentRequest) GetEnvironmentName() string {
 	if x != nil {
-		return x.Success
+		return x.EnvironmentName
 	}
-	return false
+	return ""
 }
 
-type DockerLoginRequest struct {
+type DescribeEnvironmentReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Server          string `protobuf:"bytes,1,opt,name=Server,proto3" json:"Server,omitempty"`
-	Username        string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
-	Password        string `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
-	Email           string `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
-	Name            string `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
-	EnvironmentName string `protobuf:"bytes,6,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	Environment    *Environment     `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Services       []*ServiceInfo   `protobuf:"bytes,2,rep,name=Services,proto3" json:"Services,omitempty"`
+	RegistryLogins []*RegistryLogin `protobuf:"bytes,3,rep,name=RegistryLogins,proto3" json:"RegistryLogins,omitempty"`
+	// number of env vars (and secret env vars) per service
+	EnvCounts       map[string]int32 `protobuf:"bytes,4,rep,name=EnvCounts,proto3" json:"EnvCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	SecretEnvCounts map[string]int32 `protobuf:"bytes,5,rep,name=SecretEnvCounts,proto3" json:"SecretEnvCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Usage           *ResourceUsage   `protobuf:"bytes,6,opt,name=Usage,proto3" json:"Usage,omitempty"`
 }
 
-func (x *DockerLoginRequest) Reset() {
-	*x = DockerLoginRequest{}
+func (x *DescribeEnvironmentReply) Reset() {
+	*x = DescribeEnvironmentReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -608,13 +1065,13 @@ func (x *DockerLoginRequest) Reset() {
 	}
 }
 
-func (x *DockerLoginRequest) String() string {
+func (x *DescribeEnvironmentReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DockerLoginRequest) ProtoMessage() {}
+func (*DescribeEnvironmentReply) ProtoMessage() {}
 
-func (x *DockerLoginRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeEnvironmentReply) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,63 +1083,64 @@ func (x *DockerLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DockerLoginRequest.ProtoReflect.Descriptor instead.
-func (*DockerLoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeEnvironmentReply.ProtoReflect.Descriptor instead.
+func (*DescribeEnvironmentReply) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DockerLoginRequest) GetServer() string {
+func (x *DescribeEnvironmentReply) GetEnvironment() *Environment {
 	if x != nil {
-		return x.Server
+		return x.Environment
 	}
-	return ""
+	return nil
 }
 
-func (x *DockerLoginRequest) GetUsername() string {
+func (x *DescribeEnvironmentReply) GetServices() []*ServiceInfo {
 	if x != nil {
-		return x.Username
+		return x.Services
 	}
-	return ""
+	return nil
 }
 
-func (x *DockerLoginRequest) GetPassword() string {
+func (x *DescribeEnvironmentReply) GetRegistryLogins() []*RegistryLogin {
 	if x != nil {
-		return x.Password
+		return x.RegistryLogins
 	}
-	return ""
+	return nil
 }
 
-func (x *DockerLoginRequest) GetEmail() string {
+func (x *DescribeEnvironmentReply) GetEnvCounts() map[string]int32 {
 	if x != nil {
-		return x.Email
+		return x.EnvCounts
 	}
-	return ""
+	return nil
 }
 
-func (x *DockerLoginRequest) GetName() string {
+func (x *DescribeEnvironmentReply) GetSecretEnvCounts() map[string]int32 {
 	if x != nil {
-		return x.Name
+		return x.SecretEnvCounts
 	}
-	return ""
+	return nil
 }
 
-func (x *DockerLoginRequest) GetEnvironmentName() string {
+func (x *DescribeEnvironmentReply) GetUsage() *ResourceUsage {
 	if x != nil {
-		return x.EnvironmentName
+		return x.Usage
 	}
-	return ""
+	return nil
 }
 
-type DockerLoginReply struct {
+type SetEnvironmentTierRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	Tier            string `protobuf:"bytes,2,opt,name=Tier,proto3" json:"Tier,omitempty"`
 }
 
-func (x *DockerLoginReply) Reset() {
-	*x = DockerLoginReply{}
+func (x *SetEnvironmentTierRequest) Reset() {
+	*x = SetEnvironmentTierRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,13 +1148,13 @@ func (x *DockerLoginReply) Reset() {
 	}
 }
 
-func (x *DockerLoginReply) String() string {
+func (x *SetEnvironmentTierRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DockerLoginReply) ProtoMessage() {}
+func (*SetEnvironmentTierRequest) ProtoMessage() {}
 
-func (x *DockerLoginReply) ProtoReflect() protoreflect.Message {
+func (x *SetEnvironmentTierRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -708,29 +1166,35 @@ func (x *DockerLoginReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DockerLoginReply.ProtoReflect.Descriptor instead.
-func (*DockerLoginReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetEnvironmentTierRequest.ProtoReflect.Descriptor instead.
+func (*SetEnvironmentTierRequest) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DockerLoginReply) GetID() string {
+func (x *SetEnvironmentTierRequest) GetEnvironmentName() string {
 	if x != nil {
-		return x.ID
+		return x.EnvironmentName
 	}
 	return ""
 }
 
-type MetaData struct {
+func (x *SetEnvironmentTierRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+type SetEnvironmentTierReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceName     string `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
-	EnvironmentName string `protobuf:"bytes,2,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	Environment *Environment `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
 }
 
-func (x *MetaData) Reset() {
-	*x = MetaData{}
+func (x *SetEnvironmentTierReply) Reset() {
+	*x = SetEnvironmentTierReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -738,13 +1202,13 @@ func (x *MetaData) Reset() {
 	}
 }
 
-func (x *MetaData) String() string {
+func (x *SetEnvironmentTierReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetaData) ProtoMessage() {}
+func (*SetEnvironmentTierReply) ProtoMessage() {}
 
-func (x *MetaData) ProtoReflect() protoreflect.Message {
+func (x *SetEnvironmentTierReply) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -756,38 +1220,37 @@ func (x *MetaData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetaData.ProtoReflect.Descriptor instead.
-func (*MetaData) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetEnvironmentTierReply.ProtoReflect.Descriptor instead.
+func (*SetEnvironmentTierReply) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MetaData) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
-	}
-	return ""
-}
-
-func (x *MetaData) GetEnvironmentName() string {
+func (x *SetEnvironmentTierReply) GetEnvironment() *Environment {
 	if x != nil {
-		return x.EnvironmentName
+		return x.Environment
 	}
-	return ""
+	return nil
 }
 
-type UpRequest struct {
+type CloneEnvironmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*UpRequest_MetaData
-	//	*UpRequest_Chunk
-	Data isUpRequest_Data `protobuf_oneof:"Data"`
+	SourceEnvironmentName string `protobuf:"bytes,1,opt,name=SourceEnvironmentName,proto3" json:"SourceEnvironmentName,omitempty"`
+	TargetEnvironmentName string `protobuf:"bytes,2,opt,name=TargetEnvironmentName,proto3" json:"TargetEnvironmentName,omitempty"`
+	// secret env vars and registry logins aren't copied if set
+	// services with images from private registries don't come up until the clone gets a login of its own
+	ExcludeSecrets bool `protobuf:"varint,3,opt,name=ExcludeSecrets,proto3" json:"ExcludeSecrets,omitempty"`
+	// service name to the image the clone of the service runs instead
+	ImageOverrides map[string]string `protobuf:"bytes,4,rep,name=ImageOverrides,proto3" json:"ImageOverrides,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// empty means the tier of the source environment
+	Tier       string `protobuf:"bytes,5,opt,name=Tier,proto3" json:"Tier,omitempty"`
+	TTLSeconds int64  `protobuf:"varint,6,opt,name=TTLSeconds,proto3" json:"TTLSeconds,omitempty"`
 }
 
-func (x *UpRequest) Reset() {
-	*x = UpRequest{}
+func (x *CloneEnvironmentRequest) Reset() {
+	*x = CloneEnvironmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -795,13 +1258,13 @@ func (x *UpRequest) Reset() {
 	}
 }
 
-func (x *UpRequest) String() string {
+func (x *CloneEnvironmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpRequest) ProtoMessage() {}
+func (*CloneEnvironmentRequest) ProtoMessage() {}
 
-func (x *UpRequest) ProtoReflect() protoreflect.Message {
+func (x *CloneEnvironmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -813,58 +1276,64 @@ func (x *UpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpRequest.ProtoReflect.Descriptor instead.
-func (*UpRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloneEnvironmentRequest.ProtoReflect.Descriptor instead.
+func (*CloneEnvironmentRequest) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{13}
 }
 
-func (m *UpRequest) GetData() isUpRequest_Data {
-	if m != nil {
-		return m.Data
+func (x *CloneEnvironmentRequest) GetSourceEnvironmentName() string {
+	if x != nil {
+		return x.SourceEnvironmentName
 	}
-	return nil
+	return ""
 }
 
-func (x *UpRequest) GetMetaData() *MetaData {
-	if x, ok := x.GetData().(*UpRequest_MetaData); ok {
-		return x.MetaData
+func (x *CloneEnvironmentRequest) GetTargetEnvironmentName() string {
+	if x != nil {
+		return x.TargetEnvironmentName
 	}
-	return nil
+	return ""
 }
 
-func (x *UpRequest) GetChunk() []byte {
-	if x, ok := x.GetData().(*UpRequest_Chunk); ok {
-		return x.Chunk
+func (x *CloneEnvironmentRequest) GetExcludeSecrets() bool {
+	if x != nil {
+		return x.ExcludeSecrets
+	}
+	return false
+}
+
+func (x *CloneEnvironmentRequest) GetImageOverrides() map[string]string {
+	if x != nil {
+		return x.ImageOverrides
 	}
 	return nil
 }
 
-type isUpRequest_Data interface {
-	isUpRequest_Data()
+func (x *CloneEnvironmentRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
 }
 
-type UpRequest_MetaData struct {
-	MetaData *MetaData `protobuf:"bytes,1,opt,name=MetaData,proto3,oneof"`
+func (x *CloneEnvironmentRequest) GetTTLSeconds() int64 {
+	if x != nil {
+		return x.TTLSeconds
+	}
+	return 0
 }
 
-type UpRequest_Chunk struct {
-	Chunk []byte `protobuf:"bytes,2,opt,name=Chunk,proto3,oneof"`
-}
-
-func (*UpRequest_MetaData) isUpRequest_Data() {}
-
-func (*UpRequest_Chunk) isUpRequest_Data() {}
-
-type DeploymentUpdate struct {
+type CloneEnvironmentReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ID       string         `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Services []*ServiceInfo `protobuf:"bytes,2,rep,name=Services,proto3" json:"Services,omitempty"`
 }
 
-func (x *DeploymentUpdate) Reset() {
-	*x = DeploymentUpdate{}
+func (x *CloneEnvironmentReply) Reset() {
+	*x = CloneEnvironmentReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -872,13 +1341,13 @@ func (x *DeploymentUpdate) Reset() {
 	}
 }
 
-func (x *DeploymentUpdate) String() string {
+func (x *CloneEnvironmentReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploymentUpdate) ProtoMessage() {}
+func (*CloneEnvironmentReply) ProtoMessage() {}
 
-func (x *DeploymentUpdate) ProtoReflect() protoreflect.Message {
+func (x *CloneEnvironmentReply) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -890,31 +1359,38 @@ func (x *DeploymentUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploymentUpdate.ProtoReflect.Descriptor instead.
-func (*DeploymentUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloneEnvironmentReply.ProtoReflect.Descriptor instead.
+func (*CloneEnvironmentReply) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeploymentUpdate) GetMessage() string {
+func (x *CloneEnvironmentReply) GetID() string {
 	if x != nil {
-		return x.Message
+		return x.ID
 	}
 	return ""
 }
 
-type UpResponse struct {
+func (x *CloneEnvironmentReply) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// Pushes the expiry of an environment back by TTLSeconds.
+// An environment that's expired already but not deleted yet expires TTLSeconds from now.
+type ExtendEnvironmentTTLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*UpResponse_UploadStatus
-	//	*UpResponse_DeploymentUpdate
-	Data isUpResponse_Data `protobuf_oneof:"Data"`
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	TTLSeconds      int64  `protobuf:"varint,2,opt,name=TTLSeconds,proto3" json:"TTLSeconds,omitempty"`
 }
 
-func (x *UpResponse) Reset() {
-	*x = UpResponse{}
+func (x *ExtendEnvironmentTTLRequest) Reset() {
+	*x = ExtendEnvironmentTTLRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -922,13 +1398,13 @@ func (x *UpResponse) Reset() {
 	}
 }
 
-func (x *UpResponse) String() string {
+func (x *ExtendEnvironmentTTLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpResponse) ProtoMessage() {}
+func (*ExtendEnvironmentTTLRequest) ProtoMessage() {}
 
-func (x *UpResponse) ProtoReflect() protoreflect.Message {
+func (x *ExtendEnvironmentTTLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -940,59 +1416,35 @@ func (x *UpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpResponse.ProtoReflect.Descriptor instead.
-func (*UpResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExtendEnvironmentTTLRequest.ProtoReflect.Descriptor instead.
+func (*ExtendEnvironmentTTLRequest) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{15}
 }
 
-func (m *UpResponse) GetData() isUpResponse_Data {
-	if m != nil {
-		return m.Data
-	}
-	return nil
-}
-
-func (x *UpResponse) GetUploadStatus() UploadStatus {
-	if x, ok := x.GetData().(*UpResponse_UploadStatus); ok {
-		return x.UploadStatus
+func (x *ExtendEnvironmentTTLRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
 	}
-	return UploadStatus_FAILED
+	return ""
 }
 
-func (x *UpResponse) GetDeploymentUpdate() *DeploymentUpdate {
-	if x, ok := x.GetData().(*UpResponse_DeploymentUpdate); ok {
-		return x.DeploymentUpdate
+func (x *ExtendEnvironmentTTLRequest) GetTTLSeconds() int64 {
+	if x != nil {
+		return x.TTLSeconds
 	}
-	return nil
-}
-
-type isUpResponse_Data interface {
-	isUpResponse_Data()
-}
-
-type UpResponse_UploadStatus struct {
-	UploadStatus UploadStatus `protobuf:"varint,1,opt,name=UploadStatus,proto3,enum=UploadStatus,oneof"`
-}
-
-type UpResponse_DeploymentUpdate struct {
-	DeploymentUpdate *DeploymentUpdate `protobuf:"bytes,2,opt,name=DeploymentUpdate,proto3,oneof"`
+	return 0
 }
 
-func (*UpResponse_UploadStatus) isUpResponse_Data() {}
-
-func (*UpResponse_DeploymentUpdate) isUpResponse_Data() {}
-
-type GetServiceUploadUrlRequest struct {
+type ExtendEnvironmentTTLReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
-	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Environment *Environment `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
 }
 
-func (x *GetServiceUploadUrlRequest) Reset() {
-	*x = GetServiceUploadUrlRequest{}
+func (x *ExtendEnvironmentTTLReply) Reset() {
+	*x = ExtendEnvironmentTTLReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1000,13 +1452,13 @@ func (x *GetServiceUploadUrlRequest) Reset() {
 	}
 }
 
-func (x *GetServiceUploadUrlRequest) String() string {
+func (x *ExtendEnvironmentTTLReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceUploadUrlRequest) ProtoMessage() {}
+func (*ExtendEnvironmentTTLReply) ProtoMessage() {}
 
-func (x *GetServiceUploadUrlRequest) ProtoReflect() protoreflect.Message {
+func (x *ExtendEnvironmentTTLReply) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1018,35 +1470,34 @@ func (x *GetServiceUploadUrlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceUploadUrlRequest.ProtoReflect.Descriptor instead.
-func (*GetServiceUploadUrlRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExtendEnvironmentTTLReply.ProtoReflect.Descriptor instead.
+func (*ExtendEnvironmentTTLReply) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetServiceUploadUrlRequest) GetEnvironmentName() string {
-	if x != nil {
-		return x.EnvironmentName
-	}
-	return ""
-}
-
-func (x *GetServiceUploadUrlRequest) GetServiceName() string {
+func (x *ExtendEnvironmentTTLReply) GetEnvironment() *Environment {
 	if x != nil {
-		return x.ServiceName
+		return x.Environment
 	}
-	return ""
+	return nil
 }
 
-type GetServiceUploadUrlResponse struct {
+// Compares an environment to another environment or to a desired spec.
+// The diff reads as the changes that turn the environment into the other one.
+type DiffEnvironmentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	// Types that are assignable to Other:
+	//	*DiffEnvironmentsRequest_OtherEnvironmentName
+	//	*DiffEnvironmentsRequest_Spec
+	Other isDiffEnvironmentsRequest_Other `protobuf_oneof:"Other"`
 }
 
-func (x *GetServiceUploadUrlResponse) Reset() {
-	*x = GetServiceUploadUrlResponse{}
+func (x *DiffEnvironmentsRequest) Reset() {
+	*x = DiffEnvironmentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1054,13 +1505,13 @@ func (x *GetServiceUploadUrlResponse) Reset() {
 	}
 }
 
-func (x *GetServiceUploadUrlResponse) String() string {
+func (x *DiffEnvironmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceUploadUrlResponse) ProtoMessage() {}
+func (*DiffEnvironmentsRequest) ProtoMessage() {}
 
-func (x *GetServiceUploadUrlResponse) ProtoReflect() protoreflect.Message {
+func (x *DiffEnvironmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1072,30 +1523,67 @@ func (x *GetServiceUploadUrlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceUploadUrlResponse.ProtoReflect.Descriptor instead.
-func (*GetServiceUploadUrlResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffEnvironmentsRequest.ProtoReflect.Descriptor instead.
+func (*DiffEnvironmentsRequest) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetServiceUploadUrlResponse) GetURL() string {
+func (x *DiffEnvironmentsRequest) GetEnvironmentName() string {
 	if x != nil {
-		return x.URL
+		return x.EnvironmentName
 	}
 	return ""
 }
 
-type DeployUrlRequest struct {
+func (m *DiffEnvironmentsRequest) GetOther() isDiffEnvironmentsRequest_Other {
+	if m != nil {
+		return m.Other
+	}
+	return nil
+}
+
+func (x *DiffEnvironmentsRequest) GetOtherEnvironmentName() string {
+	if x, ok := x.GetOther().(*DiffEnvironmentsRequest_OtherEnvironmentName); ok {
+		return x.OtherEnvironmentName
+	}
+	return ""
+}
+
+func (x *DiffEnvironmentsRequest) GetSpec() *EnvironmentSpec {
+	if x, ok := x.GetOther().(*DiffEnvironmentsRequest_Spec); ok {
+		return x.Spec
+	}
+	return nil
+}
+
+type isDiffEnvironmentsRequest_Other interface {
+	isDiffEnvironmentsRequest_Other()
+}
+
+type DiffEnvironmentsRequest_OtherEnvironmentName struct {
+	OtherEnvironmentName string `protobuf:"bytes,2,opt,name=OtherEnvironmentName,proto3,oneof"`
+}
+
+type DiffEnvironmentsRequest_Spec struct {
+	Spec *EnvironmentSpec `protobuf:"bytes,3,opt,name=Spec,proto3,oneof"`
+}
+
+func (*DiffEnvironmentsRequest_OtherEnvironmentName) isDiffEnvironmentsRequest_Other() {}
+
+func (*DiffEnvironmentsRequest_Spec) isDiffEnvironmentsRequest_Other() {}
+
+type FieldChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
-	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
-	URL             string `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	Field    string `protobuf:"bytes,1,opt,name=Field,proto3" json:"Field,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=OldValue,proto3" json:"OldValue,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=NewValue,proto3" json:"NewValue,omitempty"`
 }
 
-func (x *DeployUrlRequest) Reset() {
-	*x = DeployUrlRequest{}
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1103,13 +1591,13 @@ func (x *DeployUrlRequest) Reset() {
 	}
 }
 
-func (x *DeployUrlRequest) String() string {
+func (x *FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployUrlRequest) ProtoMessage() {}
+func (*FieldChange) ProtoMessage() {}
 
-func (x *DeployUrlRequest) ProtoReflect() protoreflect.Message {
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1121,43 +1609,47 @@ func (x *DeployUrlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployUrlRequest.ProtoReflect.Descriptor instead.
-func (*DeployUrlRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeployUrlRequest) GetEnvironmentName() string {
+func (x *FieldChange) GetField() string {
 	if x != nil {
-		return x.EnvironmentName
+		return x.Field
 	}
 	return ""
 }
 
-func (x *DeployUrlRequest) GetServiceName() string {
+func (x *FieldChange) GetOldValue() string {
 	if x != nil {
-		return x.ServiceName
+		return x.OldValue
 	}
 	return ""
 }
 
-func (x *DeployUrlRequest) GetURL() string {
+func (x *FieldChange) GetNewValue() string {
 	if x != nil {
-		return x.URL
+		return x.NewValue
 	}
 	return ""
 }
 
-type DeployUrlReply struct {
+type ServiceDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID  string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	URL string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Name string               `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type EnvChange_ChangeType `protobuf:"varint,2,opt,name=Type,proto3,enum=EnvChange_ChangeType" json:"Type,omitempty"`
+	// image and settings
+	Fields []*FieldChange `protobuf:"bytes,3,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// secret values are compared by hash and never show up
+	Env []*EnvChange `protobuf:"bytes,4,rep,name=Env,proto3" json:"Env,omitempty"`
 }
 
-func (x *DeployUrlReply) Reset() {
-	*x = DeployUrlReply{}
+func (x *ServiceDiff) Reset() {
+	*x = ServiceDiff{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1165,13 +1657,13 @@ func (x *DeployUrlReply) Reset() {
 	}
 }
 
-func (x *DeployUrlReply) String() string {
+func (x *ServiceDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployUrlReply) ProtoMessage() {}
+func (*ServiceDiff) ProtoMessage() {}
 
-func (x *DeployUrlReply) ProtoReflect() protoreflect.Message {
+func (x *ServiceDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1183,36 +1675,52 @@ func (x *DeployUrlReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployUrlReply.ProtoReflect.Descriptor instead.
-func (*DeployUrlReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceDiff.ProtoReflect.Descriptor instead.
+func (*ServiceDiff) Descriptor() ([]byte, []int) {
 	return file_cli_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeployUrlReply) GetID() string {
+func (x *ServiceDiff) GetName() string {
 	if x != nil {
-		return x.ID
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DeployUrlReply) GetURL() string {
+func (x *ServiceDiff) GetType() EnvChange_ChangeType {
 	if x != nil {
-		return x.URL
+		return x.Type
 	}
-	return ""
+	return EnvChange_ADDED
 }
 
-type ListEnvReply_KeyValue struct {
+func (x *ServiceDiff) GetFields() []*FieldChange {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ServiceDiff) GetEnv() []*EnvChange {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+type RegistryLoginChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Server      string               `protobuf:"bytes,1,opt,name=Server,proto3" json:"Server,omitempty"`
+	Type        EnvChange_ChangeType `protobuf:"varint,2,opt,name=Type,proto3,enum=EnvChange_ChangeType" json:"Type,omitempty"`
+	OldUsername string               `protobuf:"bytes,3,opt,name=OldUsername,proto3" json:"OldUsername,omitempty"`
+	NewUsername string               `protobuf:"bytes,4,opt,name=NewUsername,proto3" json:"NewUsername,omitempty"`
 }
 
-func (x *ListEnvReply_KeyValue) Reset() {
-	*x = ListEnvReply_KeyValue{}
+func (x *RegistryLoginChange) Reset() {
+	*x = RegistryLoginChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cli_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1220,13 +1728,13 @@ func (x *ListEnvReply_KeyValue) Reset() {
 	}
 }
 
-func (x *ListEnvReply_KeyValue) String() string {
+func (x *RegistryLoginChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEnvReply_KeyValue) ProtoMessage() {}
+func (*RegistryLoginChange) ProtoMessage() {}
 
-func (x *ListEnvReply_KeyValue) ProtoReflect() protoreflect.Message {
+func (x *RegistryLoginChange) ProtoReflect() protoreflect.Message {
 	mi := &file_cli_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1238,252 +1746,5564 @@ func (x *ListEnvReply_KeyValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEnvReply_KeyValue.ProtoReflect.Descriptor instead.
-func (*ListEnvReply_KeyValue) Descriptor() ([]byte, []int) {
-	return file_cli_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use RegistryLoginChange.ProtoReflect.Descriptor instead.
+func (*RegistryLoginChange) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListEnvReply_KeyValue) GetKey() string {
+func (x *RegistryLoginChange) GetServer() string {
 	if x != nil {
-		return x.Key
+		return x.Server
 	}
 	return ""
 }
 
-func (x *ListEnvReply_KeyValue) GetValue() string {
+func (x *RegistryLoginChange) GetType() EnvChange_ChangeType {
 	if x != nil {
-		return x.Value
+		return x.Type
+	}
+	return EnvChange_ADDED
+}
+
+func (x *RegistryLoginChange) GetOldUsername() string {
+	if x != nil {
+		return x.OldUsername
 	}
 	return ""
 }
 
-var File_cli_proto protoreflect.FileDescriptor
+func (x *RegistryLoginChange) GetNewUsername() string {
+	if x != nil {
+		return x.NewUsername
+	}
+	return ""
+}
 
-var file_cli_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x0b, 0x49,
-	0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x09, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x44, 0x22, 0x71, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x4c,
-	0x69, 0x73, 0x74, 0x1a, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x45,
-	0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x27, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x24, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x2a,
-	0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x44,
-	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x08, 0x4d, 0x65, 0x74,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72,
-	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x54, 0x0a, 0x09, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
-	0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x4d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42,
-	0x06, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x22, 0x68, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72,
-	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x1b,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55,
-	0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0x70, 0x0a,
-	0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22,
-	0x32, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x55, 0x52, 0x4c, 0x2a, 0x39, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a,
-	0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x32, 0xc9,
-	0x03, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a,
-	0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x0c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x28, 0x0a, 0x06, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x0e, 0x2e, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x07, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x0f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e,
-	0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x45,
-	0x6e, 0x76, 0x12, 0x0e, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x76, 0x12,
-	0x11, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x44, 0x6f, 0x63, 0x6b,
-	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x23,
-	0x0a, 0x02, 0x55, 0x70, 0x12, 0x0a, 0x2e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0b, 0x2e, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
-	0x01, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x55, 0x72, 0x6c, 0x12, 0x11, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x55, 0x72, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x55, 0x72, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x68, 0x65, 0x6c, 0x6d, 0x69, 0x63,
-	0x68, 0x2f, 0x68, 0x61, 0x69, 0x6b, 0x75, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+type DiffEnvironmentsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identical      bool                   `protobuf:"varint,1,opt,name=Identical,proto3" json:"Identical,omitempty"`
+	Services       []*ServiceDiff         `protobuf:"bytes,2,rep,name=Services,proto3" json:"Services,omitempty"`
+	RegistryLogins []*RegistryLoginChange `protobuf:"bytes,3,rep,name=RegistryLogins,proto3" json:"RegistryLogins,omitempty"`
 }
 
-var (
-	file_cli_proto_rawDescOnce sync.Once
-	file_cli_proto_rawDescData = file_cli_proto_rawDesc
-)
+func (x *DiffEnvironmentsReply) Reset() {
+	*x = DiffEnvironmentsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_cli_proto_rawDescGZIP() []byte {
-	file_cli_proto_rawDescOnce.Do(func() {
-		file_cli_proto_rawDescData = protoimpl.X.CompressGZIP(file_cli_proto_rawDescData)
-	})
-	return file_cli_proto_rawDescData
+func (x *DiffEnvironmentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_cli_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cli_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
-var file_cli_proto_goTypes = []interface{}{
-	(UploadStatus)(0),                   // 0: UploadStatus
-	(*InitRequest)(nil),                 // 1: InitRequest
-	(*InitReply)(nil),                   // 2: InitReply
-	(*DeployRequest)(nil),               // 3: DeployRequest
-	(*DeployReply)(nil),                 // 4: DeployReply
-	(*ListEnvRequest)(nil),              // 5: ListEnvRequest
-	(*ListEnvReply)(nil),                // 6: ListEnvReply
-	(*SetEnvRequest)(nil),               // 7: SetEnvRequest
-	(*SetEnvReply)(nil),                 // 8: SetEnvReply
-	(*RemoveEnvRequest)(nil),            // 9: RemoveEnvRequest
-	(*RemoveEnvReply)(nil),              // 10: RemoveEnvReply
-	(*DockerLoginRequest)(nil),          // 11: DockerLoginRequest
-	(*DockerLoginReply)(nil),            // 12: DockerLoginReply
-	(*MetaData)(nil),                    // 13: MetaData
-	(*UpRequest)(nil),                   // 14: UpRequest
-	(*DeploymentUpdate)(nil),            // 15: DeploymentUpdate
-	(*UpResponse)(nil),                  // 16: UpResponse
-	(*GetServiceUploadUrlRequest)(nil),  // 17: GetServiceUploadUrlRequest
-	(*GetServiceUploadUrlResponse)(nil), // 18: GetServiceUploadUrlResponse
-	(*DeployUrlRequest)(nil),            // 19: DeployUrlRequest
-	(*DeployUrlReply)(nil),              // 20: DeployUrlReply
-	(*ListEnvReply_KeyValue)(nil),       // 21: ListEnvReply.KeyValue
+func (*DiffEnvironmentsReply) ProtoMessage() {}
+
+func (x *DiffEnvironmentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_cli_proto_depIdxs = []int32{
-	21, // 0: ListEnvReply.List:type_name -> ListEnvReply.KeyValue
-	13, // 1: UpRequest.MetaData:type_name -> MetaData
-	0,  // 2: UpResponse.UploadStatus:type_name -> UploadStatus
-	15, // 3: UpResponse.DeploymentUpdate:type_name -> DeploymentUpdate
-	1,  // 4: CliService.Init:input_type -> InitRequest
-	3,  // 5: CliService.Deploy:input_type -> DeployRequest
-	5,  // 6: CliService.ListEnv:input_type -> ListEnvRequest
-	7,  // 7: CliService.SetEnv:input_type -> SetEnvRequest
-	9,  // 8: CliService.RemoveEnv:input_type -> RemoveEnvRequest
-	11, // 9: CliService.DockerLogin:input_type -> DockerLoginRequest
-	14, // 10: CliService.Up:input_type -> UpRequest
-	17, // 11: CliService.GetServiceUploadUrl:input_type -> GetServiceUploadUrlRequest
-	19, // 12: CliService.DeployUrl:input_type -> DeployUrlRequest
-	2,  // 13: CliService.Init:output_type -> InitReply
-	4,  // 14: CliService.Deploy:output_type -> DeployReply
-	6,  // 15: CliService.ListEnv:output_type -> ListEnvReply
-	8,  // 16: CliService.SetEnv:output_type -> SetEnvReply
-	10, // 17: CliService.RemoveEnv:output_type -> RemoveEnvReply
-	12, // 18: CliService.DockerLogin:output_type -> DockerLoginReply
-	16, // 19: CliService.Up:output_type -> UpResponse
-	18, // 20: CliService.GetServiceUploadUrl:output_type -> GetServiceUploadUrlResponse
-	20, // 21: CliService.DeployUrl:output_type -> DeployUrlReply
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use DiffEnvironmentsReply.ProtoReflect.Descriptor instead.
+func (*DiffEnvironmentsReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{21}
 }
 
-func init() { file_cli_proto_init() }
-func file_cli_proto_init() {
-	if File_cli_proto != nil {
-		return
+func (x *DiffEnvironmentsReply) GetIdentical() bool {
+	if x != nil {
+		return x.Identical
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_cli_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
+	return false
+}
+
+func (x *DiffEnvironmentsReply) GetServices() []*ServiceDiff {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *DiffEnvironmentsReply) GetRegistryLogins() []*RegistryLoginChange {
+	if x != nil {
+		return x.RegistryLogins
+	}
+	return nil
+}
+
+// Converges an environment to a haiku.yaml manifest.
+type ApplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the manifest as yaml
+	Manifest string `protobuf:"bytes,1,opt,name=Manifest,proto3" json:"Manifest,omitempty"`
+	// only reports what would change
+	DryRun bool `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	// deletes services that aren't in the manifest
+	Prune bool `protobuf:"varint,3,opt,name=Prune,proto3" json:"Prune,omitempty"`
+}
+
+func (x *ApplyRequest) Reset() {
+	*x = ApplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRequest) ProtoMessage() {}
+
+func (x *ApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ApplyRequest) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *ApplyRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ApplyRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+type ApplyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// whether the environment was (or would be) created
+	Created bool                   `protobuf:"varint,1,opt,name=Created,proto3" json:"Created,omitempty"`
+	Diff    *DiffEnvironmentsReply `protobuf:"bytes,2,opt,name=Diff,proto3" json:"Diff,omitempty"`
+	// the services that were deployed
+	Services []*ServiceInfo `protobuf:"bytes,3,rep,name=Services,proto3" json:"Services,omitempty"`
+}
+
+func (x *ApplyReply) Reset() {
+	*x = ApplyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyReply) ProtoMessage() {}
+
+func (x *ApplyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyReply.ProtoReflect.Descriptor instead.
+func (*ApplyReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ApplyReply) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *ApplyReply) GetDiff() *DiffEnvironmentsReply {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ApplyReply) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type ExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+type ExportReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifest string `protobuf:"bytes,1,opt,name=Manifest,proto3" json:"Manifest,omitempty"`
+}
+
+func (x *ExportReply) Reset() {
+	*x = ExportReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReply) ProtoMessage() {}
+
+func (x *ExportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReply.ProtoReflect.Descriptor instead.
+func (*ExportReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportReply) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+type DeleteEnvironmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	// the ID of the environment as returned by Init
+	ConfirmationToken string `protobuf:"bytes,2,opt,name=ConfirmationToken,proto3" json:"ConfirmationToken,omitempty"`
+}
+
+func (x *DeleteEnvironmentRequest) Reset() {
+	*x = DeleteEnvironmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEnvironmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnvironmentRequest) ProtoMessage() {}
+
+func (x *DeleteEnvironmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnvironmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteEnvironmentRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *DeleteEnvironmentRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+type DeleteEnvironmentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+}
+
+func (x *DeleteEnvironmentReply) Reset() {
+	*x = DeleteEnvironmentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEnvironmentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnvironmentReply) ProtoMessage() {}
+
+func (x *DeleteEnvironmentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnvironmentReply.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteEnvironmentReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// A share of the traffic of a service that goes to a particular revision.
+// Either LatestRevision is set or Revision points to a previous deploy.
+// Tagged targets get a URL of their own, that also works for targets with zero percent.
+type TrafficTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision       int64  `protobuf:"varint,1,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	LatestRevision bool   `protobuf:"varint,2,opt,name=LatestRevision,proto3" json:"LatestRevision,omitempty"`
+	Percent        int64  `protobuf:"varint,3,opt,name=Percent,proto3" json:"Percent,omitempty"`
+	Tag            string `protobuf:"bytes,4,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	// set by the server
+	URL string `protobuf:"bytes,5,opt,name=URL,proto3" json:"URL,omitempty"`
+}
+
+func (x *TrafficTarget) Reset() {
+	*x = TrafficTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTarget) ProtoMessage() {}
+
+func (x *TrafficTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTarget.ProtoReflect.Descriptor instead.
+func (*TrafficTarget) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TrafficTarget) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *TrafficTarget) GetLatestRevision() bool {
+	if x != nil {
+		return x.LatestRevision
+	}
+	return false
+}
+
+func (x *TrafficTarget) GetPercent() int64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *TrafficTarget) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TrafficTarget) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+// Resources and scaling of a service.
+// Zero values fall back to the defaults of the cluster.
+type ServiceSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a k8s quantity like "500m"
+	CPU string `protobuf:"bytes,1,opt,name=CPU,proto3" json:"CPU,omitempty"`
+	// a k8s quantity like "256Mi"
+	Memory      string `protobuf:"bytes,2,opt,name=Memory,proto3" json:"Memory,omitempty"`
+	MinReplicas int32  `protobuf:"varint,3,opt,name=MinReplicas,proto3" json:"MinReplicas,omitempty"`
+	MaxReplicas int32  `protobuf:"varint,4,opt,name=MaxReplicas,proto3" json:"MaxReplicas,omitempty"`
+	// requests a single instance handles at the same time
+	Concurrency int32 `protobuf:"varint,5,opt,name=Concurrency,proto3" json:"Concurrency,omitempty"`
+}
+
+func (x *ServiceSettings) Reset() {
+	*x = ServiceSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceSettings) ProtoMessage() {}
+
+func (x *ServiceSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceSettings.ProtoReflect.Descriptor instead.
+func (*ServiceSettings) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ServiceSettings) GetCPU() string {
+	if x != nil {
+		return x.CPU
+	}
+	return ""
+}
+
+func (x *ServiceSettings) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *ServiceSettings) GetMinReplicas() int32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+func (x *ServiceSettings) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *ServiceSettings) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type DeployRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image           string `protobuf:"bytes,1,opt,name=Image,proto3" json:"Image,omitempty"`
+	EnvironmentName string `protobuf:"bytes,2,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,3,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	// empty means all traffic goes to the new revision
+	Traffic []*TrafficTarget `protobuf:"bytes,4,rep,name=Traffic,proto3" json:"Traffic,omitempty"`
+	// unset keeps the settings of the previous revision
+	Settings *ServiceSettings `protobuf:"bytes,5,opt,name=Settings,proto3" json:"Settings,omitempty"`
+}
+
+func (x *DeployRequest) Reset() {
+	*x = DeployRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployRequest) ProtoMessage() {}
+
+func (x *DeployRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployRequest.ProtoReflect.Descriptor instead.
+func (*DeployRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeployRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *DeployRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *DeployRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *DeployRequest) GetTraffic() []*TrafficTarget {
+	if x != nil {
+		return x.Traffic
+	}
+	return nil
+}
+
+func (x *DeployRequest) GetSettings() *ServiceSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type DeployReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       string           `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	URL      string           `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Revision int64            `protobuf:"varint,3,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	Traffic  []*TrafficTarget `protobuf:"bytes,4,rep,name=Traffic,proto3" json:"Traffic,omitempty"`
+}
+
+func (x *DeployReply) Reset() {
+	*x = DeployReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployReply) ProtoMessage() {}
+
+func (x *DeployReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployReply.ProtoReflect.Descriptor instead.
+func (*DeployReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeployReply) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *DeployReply) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *DeployReply) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DeployReply) GetTraffic() []*TrafficTarget {
+	if x != nil {
+		return x.Traffic
+	}
+	return nil
+}
+
+// What happens while a deploy rolls out.
+// READY and FAILED are the last event on a stream.
+type DeployEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    DeployEvent_DeployEventType `protobuf:"varint,1,opt,name=Type,proto3,enum=DeployEvent_DeployEventType" json:"Type,omitempty"`
+	Message string                      `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	// the (k8s event) reason, if there's one
+	Reason string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	// the object the event is about, usually a pod
+	Object string `protobuf:"bytes,4,opt,name=Object,proto3" json:"Object,omitempty"`
+	// unix timestamp in seconds
+	Timestamp int64  `protobuf:"varint,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Revision  int64  `protobuf:"varint,6,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	URL       string `protobuf:"bytes,7,opt,name=URL,proto3" json:"URL,omitempty"`
+}
+
+func (x *DeployEvent) Reset() {
+	*x = DeployEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployEvent) ProtoMessage() {}
+
+func (x *DeployEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployEvent.ProtoReflect.Descriptor instead.
+func (*DeployEvent) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeployEvent) GetType() DeployEvent_DeployEventType {
+	if x != nil {
+		return x.Type
+	}
+	return DeployEvent_APPLIED
+}
+
+func (x *DeployEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeployEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeployEvent) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *DeployEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *DeployEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DeployEvent) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+// Ships exactly the image a service runs in one environment to another one.
+type PromoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceEnvironmentName string `protobuf:"bytes,1,opt,name=SourceEnvironmentName,proto3" json:"SourceEnvironmentName,omitempty"`
+	ServiceName           string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	TargetEnvironmentName string `protobuf:"bytes,3,opt,name=TargetEnvironmentName,proto3" json:"TargetEnvironmentName,omitempty"`
+	// defaults to ServiceName
+	TargetServiceName string `protobuf:"bytes,4,opt,name=TargetServiceName,proto3" json:"TargetServiceName,omitempty"`
+	// copies the plain env vars of the source service over those of the target service
+	CopyEnv bool `protobuf:"varint,5,opt,name=CopyEnv,proto3" json:"CopyEnv,omitempty"`
+}
+
+func (x *PromoteRequest) Reset() {
+	*x = PromoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteRequest) ProtoMessage() {}
+
+func (x *PromoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteRequest.ProtoReflect.Descriptor instead.
+func (*PromoteRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PromoteRequest) GetSourceEnvironmentName() string {
+	if x != nil {
+		return x.SourceEnvironmentName
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetTargetEnvironmentName() string {
+	if x != nil {
+		return x.TargetEnvironmentName
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetTargetServiceName() string {
+	if x != nil {
+		return x.TargetServiceName
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetCopyEnv() bool {
+	if x != nil {
+		return x.CopyEnv
+	}
+	return false
+}
+
+type PromoteReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	URL      string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Revision int64  `protobuf:"varint,3,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	// the image by digest
+	Image string `protobuf:"bytes,4,opt,name=Image,proto3" json:"Image,omitempty"`
+}
+
+func (x *PromoteReply) Reset() {
+	*x = PromoteReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteReply) ProtoMessage() {}
+
+func (x *PromoteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteReply.ProtoReflect.Descriptor instead.
+func (*PromoteReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PromoteReply) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *PromoteReply) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *PromoteReply) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PromoteReply) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type SetTrafficRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string           `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string           `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Traffic         []*TrafficTarget `protobuf:"bytes,3,rep,name=Traffic,proto3" json:"Traffic,omitempty"`
+}
+
+func (x *SetTrafficRequest) Reset() {
+	*x = SetTrafficRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrafficRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrafficRequest) ProtoMessage() {}
+
+func (x *SetTrafficRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrafficRequest.ProtoReflect.Descriptor instead.
+func (*SetTrafficRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetTrafficRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *SetTrafficRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *SetTrafficRequest) GetTraffic() []*TrafficTarget {
+	if x != nil {
+		return x.Traffic
+	}
+	return nil
+}
+
+type SetTrafficReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URL     string           `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	Traffic []*TrafficTarget `protobuf:"bytes,2,rep,name=Traffic,proto3" json:"Traffic,omitempty"`
+}
+
+func (x *SetTrafficReply) Reset() {
+	*x = SetTrafficReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrafficReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrafficReply) ProtoMessage() {}
+
+func (x *SetTrafficReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrafficReply.ProtoReflect.Descriptor instead.
+func (*SetTrafficReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetTrafficReply) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *SetTrafficReply) GetTraffic() []*TrafficTarget {
+	if x != nil {
+		return x.Traffic
+	}
+	return nil
+}
+
+// Checks run against the new revision between rollout steps.
+type HealthCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	// zero accepts any status below 400
+	ExpectedStatus int32 `protobuf:"varint,2,opt,name=ExpectedStatus,proto3" json:"ExpectedStatus,omitempty"`
+	// zero means no limit
+	MaxLatencyMilliseconds int64 `protobuf:"varint,3,opt,name=MaxLatencyMilliseconds,proto3" json:"MaxLatencyMilliseconds,omitempty"`
+	// number of requests per check, defaults to 3
+	Samples int32 `protobuf:"varint,4,opt,name=Samples,proto3" json:"Samples,omitempty"`
+}
+
+func (x *HealthCheck) Reset() {
+	*x = HealthCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheck) ProtoMessage() {}
+
+func (x *HealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HealthCheck) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetExpectedStatus() int32 {
+	if x != nil {
+		return x.ExpectedStatus
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetMaxLatencyMilliseconds() int64 {
+	if x != nil {
+		return x.MaxLatencyMilliseconds
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type RolloutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image           string `protobuf:"bytes,1,opt,name=Image,proto3" json:"Image,omitempty"`
+	EnvironmentName string `protobuf:"bytes,2,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,3,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	// percentages of traffic the new revision gets step by step, defaults to 10, 50, 100
+	Steps []int64 `protobuf:"varint,4,rep,packed,name=Steps,proto3" json:"Steps,omitempty"`
+	// time between steps, defaults to 30 seconds
+	StepIntervalSeconds int64        `protobuf:"varint,5,opt,name=StepIntervalSeconds,proto3" json:"StepIntervalSeconds,omitempty"`
+	HealthCheck         *HealthCheck `protobuf:"bytes,6,opt,name=HealthCheck,proto3" json:"HealthCheck,omitempty"`
+}
+
+func (x *RolloutRequest) Reset() {
+	*x = RolloutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutRequest) ProtoMessage() {}
+
+func (x *RolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutRequest.ProtoReflect.Descriptor instead.
+func (*RolloutRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RolloutRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *RolloutRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *RolloutRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *RolloutRequest) GetSteps() []int64 {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *RolloutRequest) GetStepIntervalSeconds() int64 {
+	if x != nil {
+		return x.StepIntervalSeconds
+	}
+	return 0
+}
+
+func (x *RolloutRequest) GetHealthCheck() *HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+type RolloutUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase    RolloutUpdate_RolloutPhase `protobuf:"varint,1,opt,name=Phase,proto3,enum=RolloutUpdate_RolloutPhase" json:"Phase,omitempty"`
+	Revision int64                      `protobuf:"varint,2,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	// traffic percentage of the new revision
+	Percent int64  `protobuf:"varint,3,opt,name=Percent,proto3" json:"Percent,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	URL     string `protobuf:"bytes,5,opt,name=URL,proto3" json:"URL,omitempty"`
+}
+
+func (x *RolloutUpdate) Reset() {
+	*x = RolloutUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutUpdate) ProtoMessage() {}
+
+func (x *RolloutUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutUpdate.ProtoReflect.Descriptor instead.
+func (*RolloutUpdate) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RolloutUpdate) GetPhase() RolloutUpdate_RolloutPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return RolloutUpdate_DEPLOYED
+}
+
+func (x *RolloutUpdate) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RolloutUpdate) GetPercent() int64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *RolloutUpdate) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RolloutUpdate) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type DeployRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision int64  `protobuf:"varint,1,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	Image    string `protobuf:"bytes,2,opt,name=Image,proto3" json:"Image,omitempty"`
+	// the env revision that was current when this was deployed
+	EnvRevision int64  `protobuf:"varint,3,opt,name=EnvRevision,proto3" json:"EnvRevision,omitempty"`
+	DeployedBy  string `protobuf:"bytes,4,opt,name=DeployedBy,proto3" json:"DeployedBy,omitempty"`
+	RequestID   string `protobuf:"bytes,5,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	// unix timestamp in seconds
+	Timestamp int64 `protobuf:"varint,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (x *DeployRevision) Reset() {
+	*x = DeployRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployRevision) ProtoMessage() {}
+
+func (x *DeployRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployRevision.ProtoReflect.Descriptor instead.
+func (*DeployRevision) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeployRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DeployRevision) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *DeployRevision) GetEnvRevision() int64 {
+	if x != nil {
+		return x.EnvRevision
+	}
+	return 0
+}
+
+func (x *DeployRevision) GetDeployedBy() string {
+	if x != nil {
+		return x.DeployedBy
+	}
+	return ""
+}
+
+func (x *DeployRevision) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *DeployRevision) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ListRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListRevisionsRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *ListRevisionsRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type ListRevisionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*DeployRevision `protobuf:"bytes,1,rep,name=Revisions,proto3" json:"Revisions,omitempty"`
+}
+
+func (x *ListRevisionsReply) Reset() {
+	*x = ListRevisionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevisionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsReply) ProtoMessage() {}
+
+func (x *ListRevisionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsReply.ProtoReflect.Descriptor instead.
+func (*ListRevisionsReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListRevisionsReply) GetRevisions() []*DeployRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RollbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Revision        int64  `protobuf:"varint,3,opt,name=Revision,proto3" json:"Revision,omitempty"`
+}
+
+func (x *RollbackRequest) Reset() {
+	*x = RollbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackRequest) ProtoMessage() {}
+
+func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RollbackRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// A rollback is a deploy like any other and creates a new revision.
+type RollbackReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	URL      string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Revision int64  `protobuf:"varint,3,opt,name=Revision,proto3" json:"Revision,omitempty"`
+}
+
+func (x *RollbackReply) Reset() {
+	*x = RollbackReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackReply) ProtoMessage() {}
+
+func (x *RollbackReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackReply.ProtoReflect.Descriptor instead.
+func (*RollbackReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RollbackReply) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *RollbackReply) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *RollbackReply) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// A haiku service as it is deployed right now.
+type ServiceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Image    string `protobuf:"bytes,3,opt,name=Image,proto3" json:"Image,omitempty"`
+	URL      string `protobuf:"bytes,4,opt,name=URL,proto3" json:"URL,omitempty"`
+	Revision int64  `protobuf:"varint,5,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	Ready    bool   `protobuf:"varint,6,opt,name=Ready,proto3" json:"Ready,omitempty"`
+	// unix timestamp in seconds
+	CreatedAt  int64            `protobuf:"varint,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	DeployedBy string           `protobuf:"bytes,8,opt,name=DeployedBy,proto3" json:"DeployedBy,omitempty"`
+	Settings   *ServiceSettings `protobuf:"bytes,9,opt,name=Settings,proto3" json:"Settings,omitempty"`
+	Traffic    []*TrafficTarget `protobuf:"bytes,10,rep,name=Traffic,proto3" json:"Traffic,omitempty"`
+}
+
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInfo) ProtoMessage() {}
+
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ServiceInfo) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ServiceInfo) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ServiceInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ServiceInfo) GetDeployedBy() string {
+	if x != nil {
+		return x.DeployedBy
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetSettings() *ServiceSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetTraffic() []*TrafficTarget {
+	if x != nil {
+		return x.Traffic
+	}
+	return nil
+}
+
+type ListServicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+}
+
+func (x *ListServicesRequest) Reset() {
+	*x = ListServicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesRequest) ProtoMessage() {}
+
+func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListServicesRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListServicesRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+type ListServicesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services []*ServiceInfo `protobuf:"bytes,1,rep,name=Services,proto3" json:"Services,omitempty"`
+}
+
+func (x *ListServicesReply) Reset() {
+	*x = ListServicesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServicesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesReply) ProtoMessage() {}
+
+func (x *ListServicesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesReply.ProtoReflect.Descriptor instead.
+func (*ListServicesReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListServicesReply) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type GetServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *GetServiceRequest) Reset() {
+	*x = GetServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRequest) ProtoMessage() {}
+
+func (x *GetServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetServiceRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *GetServiceRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type GetServiceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service *ServiceInfo `protobuf:"bytes,1,opt,name=Service,proto3" json:"Service,omitempty"`
+}
+
+func (x *GetServiceReply) Reset() {
+	*x = GetServiceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceReply) ProtoMessage() {}
+
+func (x *GetServiceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceReply.ProtoReflect.Descriptor instead.
+func (*GetServiceReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetServiceReply) GetService() *ServiceInfo {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type DeleteServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *DeleteServiceRequest) Reset() {
+	*x = DeleteServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceRequest) ProtoMessage() {}
+
+func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteServiceRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *DeleteServiceRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type DeleteServiceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+}
+
+func (x *DeleteServiceReply) Reset() {
+	*x = DeleteServiceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceReply) ProtoMessage() {}
+
+func (x *DeleteServiceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceReply.ProtoReflect.Descriptor instead.
+func (*DeleteServiceReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteServiceReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListEnvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *ListEnvRequest) Reset() {
+	*x = ListEnvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnvRequest) ProtoMessage() {}
+
+func (x *ListEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnvRequest.ProtoReflect.Descriptor instead.
+func (*ListEnvRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListEnvRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *ListEnvRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type ListEnvReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*ListEnvReply_KeyValue `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty"`
+}
+
+func (x *ListEnvReply) Reset() {
+	*x = ListEnvReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnvReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnvReply) ProtoMessage() {}
+
+func (x *ListEnvReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnvReply.ProtoReflect.Descriptor instead.
+func (*ListEnvReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListEnvReply) GetList() []*ListEnvReply_KeyValue {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type SetEnvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key             string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Value           string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	ProjectName     string `protobuf:"bytes,3,opt,name=ProjectName,proto3" json:"ProjectName,omitempty"`
+	ServiceName     string `protobuf:"bytes,4,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	EnvironmentName string `protobuf:"bytes,5,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	Secret          bool   `protobuf:"varint,6,opt,name=Secret,proto3" json:"Secret,omitempty"`
+}
+
+func (x *SetEnvRequest) Reset() {
+	*x = SetEnvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnvRequest) ProtoMessage() {}
+
+func (x *SetEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnvRequest.ProtoReflect.Descriptor instead.
+func (*SetEnvRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetEnvRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetEnvRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetEnvRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *SetEnvRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *SetEnvRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *SetEnvRequest) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+type SetEnvReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+}
+
+func (x *SetEnvReply) Reset() {
+	*x = SetEnvReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEnvReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnvReply) ProtoMessage() {}
+
+func (x *SetEnvReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnvReply.ProtoReflect.Descriptor instead.
+func (*SetEnvReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetEnvReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveEnvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key             string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	EnvironmentName string `protobuf:"bytes,2,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,3,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *RemoveEnvRequest) Reset() {
+	*x = RemoveEnvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEnvRequest) ProtoMessage() {}
+
+func (x *RemoveEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEnvRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEnvRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RemoveEnvRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RemoveEnvRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *RemoveEnvRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type RemoveEnvReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+}
+
+func (x *RemoveEnvReply) Reset() {
+	*x = RemoveEnvReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveEnvReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEnvReply) ProtoMessage() {}
+
+func (x *RemoveEnvReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEnvReply.ProtoReflect.Descriptor instead.
+func (*RemoveEnvReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RemoveEnvReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ImportEnvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	// dotenv formatted payload
+	Data   string     `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	Mode   ImportMode `protobuf:"varint,4,opt,name=Mode,proto3,enum=ImportMode" json:"Mode,omitempty"`
+	Secret bool       `protobuf:"varint,5,opt,name=Secret,proto3" json:"Secret,omitempty"`
+}
+
+func (x *ImportEnvRequest) Reset() {
+	*x = ImportEnvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEnvRequest) ProtoMessage() {}
+
+func (x *ImportEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEnvRequest.ProtoReflect.Descriptor instead.
+func (*ImportEnvRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ImportEnvRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *ImportEnvRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ImportEnvRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ImportEnvRequest) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_MERGE
+}
+
+func (x *ImportEnvRequest) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+type ImportEnvReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *ImportEnvReply) Reset() {
+	*x = ImportEnvReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEnvReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEnvReply) ProtoMessage() {}
+
+func (x *ImportEnvReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEnvReply.ProtoReflect.Descriptor instead.
+func (*ImportEnvReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportEnvReply) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ExportEnvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *ExportEnvRequest) Reset() {
+	*x = ExportEnvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEnvRequest) ProtoMessage() {}
+
+func (x *ExportEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEnvRequest.ProtoReflect.Descriptor instead.
+func (*ExportEnvRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ExportEnvRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *ExportEnvRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+// Secret env vars are listed as comments without their values.
+type ExportEnvReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *ExportEnvReply) Reset() {
+	*x = ExportEnvReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportEnvReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEnvReply) ProtoMessage() {}
+
+func (x *ExportEnvReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEnvReply.ProtoReflect.Descriptor instead.
+func (*ExportEnvReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ExportEnvReply) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type EnvChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string               `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Type EnvChange_ChangeType `protobuf:"varint,2,opt,name=Type,proto3,enum=EnvChange_ChangeType" json:"Type,omitempty"`
+	// values of secret env vars are masked
+	OldValue string `protobuf:"bytes,3,opt,name=OldValue,proto3" json:"OldValue,omitempty"`
+	NewValue string `protobuf:"bytes,4,opt,name=NewValue,proto3" json:"NewValue,omitempty"`
+	Secret   bool   `protobuf:"varint,5,opt,name=Secret,proto3" json:"Secret,omitempty"`
+}
+
+func (x *EnvChange) Reset() {
+	*x = EnvChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvChange) ProtoMessage() {}
+
+func (x *EnvChange) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvChange.ProtoReflect.Descriptor instead.
+func (*EnvChange) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EnvChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EnvChange) GetType() EnvChange_ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return EnvChange_ADDED
+}
+
+func (x *EnvChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *EnvChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *EnvChange) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+type EnvRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision  int64  `protobuf:"varint,1,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	Author    string `protobuf:"bytes,2,opt,name=Author,proto3" json:"Author,omitempty"`
+	RequestID string `protobuf:"bytes,3,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	// unix timestamp in seconds
+	Timestamp int64        `protobuf:"varint,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Changes   []*EnvChange `protobuf:"bytes,5,rep,name=Changes,proto3" json:"Changes,omitempty"`
+}
+
+func (x *EnvRevision) Reset() {
+	*x = EnvRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvRevision) ProtoMessage() {}
+
+func (x *EnvRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvRevision.ProtoReflect.Descriptor instead.
+func (*EnvRevision) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *EnvRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EnvRevision) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *EnvRevision) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *EnvRevision) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *EnvRevision) GetChanges() []*EnvChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ListEnvRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *ListEnvRevisionsRequest) Reset() {
+	*x = ListEnvRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnvRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnvRevisionsRequest) ProtoMessage() {}
+
+func (x *ListEnvRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnvRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnvRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListEnvRevisionsRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *ListEnvRevisionsRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type ListEnvRevisionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*EnvRevision `protobuf:"bytes,1,rep,name=Revisions,proto3" json:"Revisions,omitempty"`
+}
+
+func (x *ListEnvRevisionsReply) Reset() {
+	*x = ListEnvRevisionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnvRevisionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnvRevisionsReply) ProtoMessage() {}
+
+func (x *ListEnvRevisionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnvRevisionsReply.ProtoReflect.Descriptor instead.
+func (*ListEnvRevisionsReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListEnvRevisionsReply) GetRevisions() []*EnvRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RestoreEnvRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Revision        int64  `protobuf:"varint,3,opt,name=Revision,proto3" json:"Revision,omitempty"`
+}
+
+func (x *RestoreEnvRevisionRequest) Reset() {
+	*x = RestoreEnvRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreEnvRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEnvRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreEnvRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEnvRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEnvRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RestoreEnvRevisionRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *RestoreEnvRevisionRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *RestoreEnvRevisionRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// Restoring is a change like any other and creates a new revision.
+type RestoreEnvRevisionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision int64 `protobuf:"varint,1,opt,name=Revision,proto3" json:"Revision,omitempty"`
+}
+
+func (x *RestoreEnvRevisionReply) Reset() {
+	*x = RestoreEnvRevisionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreEnvRevisionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEnvRevisionReply) ProtoMessage() {}
+
+func (x *RestoreEnvRevisionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEnvRevisionReply.ProtoReflect.Descriptor instead.
+func (*RestoreEnvRevisionReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RestoreEnvRevisionReply) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type DockerLoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Server          string `protobuf:"bytes,1,opt,name=Server,proto3" json:"Server,omitempty"`
+	Username        string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password        string `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
+	Email           string `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
+	Name            string `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
+	EnvironmentName string `protobuf:"bytes,6,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+}
+
+func (x *DockerLoginRequest) Reset() {
+	*x = DockerLoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DockerLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerLoginRequest) ProtoMessage() {}
+
+func (x *DockerLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerLoginRequest.ProtoReflect.Descriptor instead.
+func (*DockerLoginRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DockerLoginRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *DockerLoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DockerLoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DockerLoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DockerLoginRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DockerLoginRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+type DockerLoginReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DockerLoginReply) Reset() {
+	*x = DockerLoginReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DockerLoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerLoginReply) ProtoMessage() {}
+
+func (x *DockerLoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerLoginReply.ProtoReflect.Descriptor instead.
+func (*DockerLoginReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DockerLoginReply) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type MetaData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceName     string `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	EnvironmentName string `protobuf:"bytes,2,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	// hex encoded sha256 of the archive, verified if set
+	Checksum string `protobuf:"bytes,3,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
+}
+
+func (x *MetaData) Reset() {
+	*x = MetaData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetaData) ProtoMessage() {}
+
+func (x *MetaData) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetaData.ProtoReflect.Descriptor instead.
+func (*MetaData) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MetaData) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *MetaData) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *MetaData) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type UpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UpRequest_MetaData
+	//	*UpRequest_Chunk
+	Data isUpRequest_Data `protobuf_oneof:"Data"`
+}
+
+func (x *UpRequest) Reset() {
+	*x = UpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpRequest) ProtoMessage() {}
+
+func (x *UpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpRequest.ProtoReflect.Descriptor instead.
+func (*UpRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{71}
+}
+
+func (m *UpRequest) GetData() isUpRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UpRequest) GetMetaData() *MetaData {
+	if x, ok := x.GetData().(*UpRequest_MetaData); ok {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *UpRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*UpRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUpRequest_Data interface {
+	isUpRequest_Data()
+}
+
+type UpRequest_MetaData struct {
+	MetaData *MetaData `protobuf:"bytes,1,opt,name=MetaData,proto3,oneof"`
+}
+
+type UpRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=Chunk,proto3,oneof"`
+}
+
+func (*UpRequest_MetaData) isUpRequest_Data() {}
+
+func (*UpRequest_Chunk) isUpRequest_Data() {}
+
+// DeploymentUpdate keeps the client posted while an uploaded archive is built and deployed.
+type DeploymentUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	BuildID string `protobuf:"bytes,2,opt,name=BuildID,proto3" json:"BuildID,omitempty"`
+	// fetch, build or deploy
+	Stage     string                       `protobuf:"bytes,3,opt,name=Stage,proto3" json:"Stage,omitempty"`
+	Status    DeploymentUpdate_StageStatus `protobuf:"varint,4,opt,name=Status,proto3,enum=DeploymentUpdate_StageStatus" json:"Status,omitempty"`
+	Timestamp int64                        `protobuf:"varint,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	// set once the build pushed the image
+	Image string `protobuf:"bytes,6,opt,name=Image,proto3" json:"Image,omitempty"`
+	// set once the service is ready
+	URL string `protobuf:"bytes,7,opt,name=URL,proto3" json:"URL,omitempty"`
+	// the step of the stage a log line came from
+	Step string `protobuf:"bytes,8,opt,name=Step,proto3" json:"Step,omitempty"`
+	// k8s interleaves stdout and stderr of a step, this is always "combined" for now
+	Stream string `protobuf:"bytes,9,opt,name=Stream,proto3" json:"Stream,omitempty"`
+}
+
+func (x *DeploymentUpdate) Reset() {
+	*x = DeploymentUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploymentUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentUpdate) ProtoMessage() {}
+
+func (x *DeploymentUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentUpdate.ProtoReflect.Descriptor instead.
+func (*DeploymentUpdate) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeploymentUpdate) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeploymentUpdate) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+func (x *DeploymentUpdate) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *DeploymentUpdate) GetStatus() DeploymentUpdate_StageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeploymentUpdate_STARTED
+}
+
+func (x *DeploymentUpdate) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *DeploymentUpdate) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *DeploymentUpdate) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *DeploymentUpdate) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *DeploymentUpdate) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type UploadProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// always IN_PROGRESS, the end of the upload is reported once as UploadStatus
+	Status        UploadStatus `protobuf:"varint,1,opt,name=Status,proto3,enum=UploadStatus" json:"Status,omitempty"`
+	BytesReceived int64        `protobuf:"varint,2,opt,name=BytesReceived,proto3" json:"BytesReceived,omitempty"`
+	// the key of the archive in object storage
+	Key string `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (x *UploadProgress) Reset() {
+	*x = UploadProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProgress) ProtoMessage() {}
+
+func (x *UploadProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProgress.ProtoReflect.Descriptor instead.
+func (*UploadProgress) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UploadProgress) GetStatus() UploadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UploadStatus_FAILED
+}
+
+func (x *UploadProgress) GetBytesReceived() int64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *UploadProgress) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type UpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UpResponse_UploadStatus
+	//	*UpResponse_DeploymentUpdate
+	//	*UpResponse_UploadProgress
+	Data isUpResponse_Data `protobuf_oneof:"Data"`
+}
+
+func (x *UpResponse) Reset() {
+	*x = UpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpResponse) ProtoMessage() {}
+
+func (x *UpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpResponse.ProtoReflect.Descriptor instead.
+func (*UpResponse) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{74}
+}
+
+func (m *UpResponse) GetData() isUpResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UpResponse) GetUploadStatus() UploadStatus {
+	if x, ok := x.GetData().(*UpResponse_UploadStatus); ok {
+		return x.UploadStatus
+	}
+	return UploadStatus_FAILED
+}
+
+func (x *UpResponse) GetDeploymentUpdate() *DeploymentUpdate {
+	if x, ok := x.GetData().(*UpResponse_DeploymentUpdate); ok {
+		return x.DeploymentUpdate
+	}
+	return nil
+}
+
+func (x *UpResponse) GetUploadProgress() *UploadProgress {
+	if x, ok := x.GetData().(*UpResponse_UploadProgress); ok {
+		return x.UploadProgress
+	}
+	return nil
+}
+
+type isUpResponse_Data interface {
+	isUpResponse_Data()
+}
+
+type UpResponse_UploadStatus struct {
+	UploadStatus UploadStatus `protobuf:"varint,1,opt,name=UploadStatus,proto3,enum=UploadStatus,oneof"`
+}
+
+type UpResponse_DeploymentUpdate struct {
+	DeploymentUpdate *DeploymentUpdate `protobuf:"bytes,2,opt,name=DeploymentUpdate,proto3,oneof"`
+}
+
+type UpResponse_UploadProgress struct {
+	UploadProgress *UploadProgress `protobuf:"bytes,3,opt,name=UploadProgress,proto3,oneof"`
+}
+
+func (*UpResponse_UploadStatus) isUpResponse_Data() {}
+
+func (*UpResponse_DeploymentUpdate) isUpResponse_Data() {}
+
+func (*UpResponse_UploadProgress) isUpResponse_Data() {}
+
+type GetServiceUploadUrlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *GetServiceUploadUrlRequest) Reset() {
+	*x = GetServiceUploadUrlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceUploadUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceUploadUrlRequest) ProtoMessage() {}
+
+func (x *GetServiceUploadUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceUploadUrlRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceUploadUrlRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetServiceUploadUrlRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *GetServiceUploadUrlRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type GetServiceUploadUrlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+}
+
+func (x *GetServiceUploadUrlResponse) Reset() {
+	*x = GetServiceUploadUrlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceUploadUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceUploadUrlResponse) ProtoMessage() {}
+
+func (x *GetServiceUploadUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceUploadUrlResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceUploadUrlResponse) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetServiceUploadUrlResponse) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type DeployUrlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	ServiceName     string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	// the url from GetServiceUploadUrl the archive was uploaded to
+	URL string `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+}
+
+func (x *DeployUrlRequest) Reset() {
+	*x = DeployUrlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployUrlRequest) ProtoMessage() {}
+
+func (x *DeployUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployUrlRequest.ProtoReflect.Descriptor instead.
+func (*DeployUrlRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeployUrlRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *DeployUrlRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *DeployUrlRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type DeployUrlReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the id of the build, GetBuild has the url once the build is deployed
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// empty if the service doesn't exist yet
+	URL string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+}
+
+func (x *DeployUrlReply) Reset() {
+	*x = DeployUrlReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployUrlReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployUrlReply) ProtoMessage() {}
+
+func (x *DeployUrlReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployUrlReply.ProtoReflect.Descriptor instead.
+func (*DeployUrlReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeployUrlReply) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *DeployUrlReply) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type GetBuildLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	BuildID         string `protobuf:"bytes,2,opt,name=BuildID,proto3" json:"BuildID,omitempty"`
+}
+
+func (x *GetBuildLogsRequest) Reset() {
+	*x = GetBuildLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuildLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildLogsRequest) ProtoMessage() {}
+
+func (x *GetBuildLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildLogsRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetBuildLogsRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *GetBuildLogsRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+type GetBuildLogsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log string `protobuf:"bytes,1,opt,name=Log,proto3" json:"Log,omitempty"`
+}
+
+func (x *GetBuildLogsReply) Reset() {
+	*x = GetBuildLogsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuildLogsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildLogsReply) ProtoMessage() {}
+
+func (x *GetBuildLogsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildLogsReply.ProtoReflect.Descriptor instead.
+func (*GetBuildLogsReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetBuildLogsReply) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+type Build struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ServiceName string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	// the key of the archive in object storage
+	SourceKey   string `protobuf:"bytes,3,opt,name=SourceKey,proto3" json:"SourceKey,omitempty"`
+	RequestedBy string `protobuf:"bytes,4,opt,name=RequestedBy,proto3" json:"RequestedBy,omitempty"`
+	RequestID   string `protobuf:"bytes,5,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	StartedAt   int64  `protobuf:"varint,6,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	FinishedAt  int64  `protobuf:"varint,7,opt,name=FinishedAt,proto3" json:"FinishedAt,omitempty"`
+	// repository@digest, set once the image is pushed
+	Image string `protobuf:"bytes,8,opt,name=Image,proto3" json:"Image,omitempty"`
+	// SUCCEEDED means the image is pushed and waits to be deployed
+	Status Build_BuildStatus `protobuf:"varint,9,opt,name=Status,proto3,enum=Build_BuildStatus" json:"Status,omitempty"`
+	// why the build (or its deploy) failed
+	Message string `protobuf:"bytes,10,opt,name=Message,proto3" json:"Message,omitempty"`
+	// where the service serves, set once the build is deployed
+	URL string `protobuf:"bytes,11,opt,name=URL,proto3" json:"URL,omitempty"`
+	// the revision of the service the build was deployed as
+	Revision int64 `protobuf:"varint,12,opt,name=Revision,proto3" json:"Revision,omitempty"`
+}
+
+func (x *Build) Reset() {
+	*x = Build{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Build) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Build) ProtoMessage() {}
+
+func (x *Build) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Build.ProtoReflect.Descriptor instead.
+func (*Build) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Build) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Build) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *Build) GetSourceKey() string {
+	if x != nil {
+		return x.SourceKey
+	}
+	return ""
+}
+
+func (x *Build) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *Build) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *Build) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Build) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *Build) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Build) GetStatus() Build_BuildStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Build_PENDING
+}
+
+func (x *Build) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Build) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *Build) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ListBuildsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	// optional, all builds of the environment if empty
+	ServiceName string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+}
+
+func (x *ListBuildsRequest) Reset() {
+	*x = ListBuildsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBuildsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildsRequest) ProtoMessage() {}
+
+func (x *ListBuildsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildsRequest.ProtoReflect.Descriptor instead.
+func (*ListBuildsRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListBuildsRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *ListBuildsRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type ListBuildsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Builds []*Build `protobuf:"bytes,1,rep,name=Builds,proto3" json:"Builds,omitempty"`
+}
+
+func (x *ListBuildsReply) Reset() {
+	*x = ListBuildsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBuildsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildsReply) ProtoMessage() {}
+
+func (x *ListBuildsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildsReply.ProtoReflect.Descriptor instead.
+func (*ListBuildsReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListBuildsReply) GetBuilds() []*Build {
+	if x != nil {
+		return x.Builds
+	}
+	return nil
+}
+
+type GetBuildRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	BuildID         string `protobuf:"bytes,2,opt,name=BuildID,proto3" json:"BuildID,omitempty"`
+}
+
+func (x *GetBuildRequest) Reset() {
+	*x = GetBuildRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildRequest) ProtoMessage() {}
+
+func (x *GetBuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetBuildRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *GetBuildRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+type GetBuildReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Build *Build `protobuf:"bytes,1,opt,name=Build,proto3" json:"Build,omitempty"`
+}
+
+func (x *GetBuildReply) Reset() {
+	*x = GetBuildReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuildReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildReply) ProtoMessage() {}
+
+func (x *GetBuildReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildReply.ProtoReflect.Descriptor instead.
+func (*GetBuildReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetBuildReply) GetBuild() *Build {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+// Stops a build before its image is deployed.
+// A build that is deploying or deployed can't be cancelled anymore, roll the service back instead.
+type CancelBuildRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentName string `protobuf:"bytes,1,opt,name=EnvironmentName,proto3" json:"EnvironmentName,omitempty"`
+	BuildID         string `protobuf:"bytes,2,opt,name=BuildID,proto3" json:"BuildID,omitempty"`
+}
+
+func (x *CancelBuildRequest) Reset() {
+	*x = CancelBuildRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBuildRequest) ProtoMessage() {}
+
+func (x *CancelBuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBuildRequest.ProtoReflect.Descriptor instead.
+func (*CancelBuildRequest) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CancelBuildRequest) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *CancelBuildRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+type CancelBuildReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Build *Build `protobuf:"bytes,1,opt,name=Build,proto3" json:"Build,omitempty"`
+}
+
+func (x *CancelBuildReply) Reset() {
+	*x = CancelBuildReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBuildReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBuildReply) ProtoMessage() {}
+
+func (x *CancelBuildReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBuildReply.ProtoReflect.Descriptor instead.
+func (*CancelBuildReply) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CancelBuildReply) GetBuild() *Build {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+type ListEnvReply_KeyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Secret bool   `protobuf:"varint,3,opt,name=Secret,proto3" json:"Secret,omitempty"`
+}
+
+func (x *ListEnvReply_KeyValue) Reset() {
+	*x = ListEnvReply_KeyValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnvReply_KeyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnvReply_KeyValue) ProtoMessage() {}
+
+func (x *ListEnvReply_KeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnvReply_KeyValue.ProtoReflect.Descriptor instead.
+func (*ListEnvReply_KeyValue) Descriptor() ([]byte, []int) {
+	return file_cli_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *ListEnvReply_KeyValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListEnvReply_KeyValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ListEnvReply_KeyValue) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+var File_cli_proto protoreflect.FileDescriptor
+
+var file_cli_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x0b,
+	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x54, 0x4c,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54,
+	0x54, 0x4c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x1b, 0x0a, 0x09, 0x49, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x67, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x0d, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x50, 0x6f, 0x64, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x43, 0x50, 0x55, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x50, 0x55, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x50, 0x55,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x50, 0x55,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0xbf, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x27, 0x0a, 0x03, 0x45, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x6e, 0x76,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x45, 0x6e, 0x76, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x2c, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x0f, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x08,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x0e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x19,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x30, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x1a, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xf6, 0x03, 0x0a,
+	0x18, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x0b, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x0e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x09, 0x45,
+	0x6e, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x45, 0x6e, 0x76, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x45, 0x6e, 0x76, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x45, 0x6e, 0x76,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x45, 0x6e,
+	0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a,
+	0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x6e, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x42, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x65, 0x72,
+	0x22, 0x49, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x0b, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xfa, 0x02, 0x0a, 0x17,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x15, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x45, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x0e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x54, 0x4c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x6e,
+	0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x1b, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x54, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0x4b, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x2e, 0x0a, 0x0b, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0xaa, 0x01, 0x0a, 0x17, 0x44, 0x69, 0x66, 0x66, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x14, 0x4f, 0x74, 0x68, 0x65, 0x72,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x14, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x04, 0x53, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52,
+	0x04, 0x53, 0x70, 0x65, 0x63, 0x42, 0x07, 0x0a, 0x05, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x22, 0x5b,
+	0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0b,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x45, 0x6e, 0x76, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x1c, 0x0a, 0x03, 0x45, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x45, 0x6e, 0x76, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x03, 0x45, 0x6e, 0x76, 0x22, 0x9c,
+	0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x29,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x45,
+	0x6e, 0x76, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x6c, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x4f, 0x6c, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4e,
+	0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x01,
+	0x0a, 0x15, 0x44, 0x69, 0x66, 0x66, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x58, 0x0a,
+	0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x22, 0x7c, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x04, 0x44, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x04, 0x44, 0x69, 0x66, 0x66, 0x12, 0x28, 0x0a, 0x08, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x29, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x18, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x32, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x54, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x43,
+	0x50, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a,
+	0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x4d, 0x69, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x61, 0x78, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x4d, 0x61,
+	0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc9, 0x01, 0x0a, 0x0d,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x52, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x2c, 0x0a, 0x08, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x75, 0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x22, 0xff,
+	0x02, 0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0xa7, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x50,
+	0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x56, 0x49, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x50, 0x4f, 0x44, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x49, 0x4e,
+	0x47, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07,
+	0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x08,
+	0x22, 0xe6, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x6f, 0x70, 0x79, 0x45, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x43, 0x6f, 0x70, 0x79, 0x45, 0x6e, 0x76, 0x22, 0x62, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x89, 0x01,
+	0x0a, 0x11, 0x53, 0x65, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x52, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x22, 0x4d, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x28,
+	0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x22, 0x9b, 0x01, 0x0a, 0x0b, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0e,
+	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x4d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x4d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x22, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x52, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0x55, 0x0a, 0x0c, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45,
+	0x50, 0x4c, 0x4f, 0x59, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x49, 0x46,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10,
+	0x04, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x45, 0x6e, 0x76,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x64, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x62, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d,
+	0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a,
+	0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa1, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x42,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x28, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x22, 0x3f, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x26, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x62, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x5c, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x4a, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0x27, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x70, 0x0a, 0x10, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a,
+	0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f,
+	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x26, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x5e, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x24, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xcb, 0x01, 0x0a, 0x09, 0x45, 0x6e, 0x76, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x45, 0x6e, 0x76, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x31, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09,
+	0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x02, 0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x45, 0x6e, 0x76, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x09, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x45,
+	0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x17, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x72, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x54, 0x0a, 0x09, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd4, 0x02, 0x0a, 0x10,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x22, 0x4d, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f,
+	0x47, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x04, 0x22, 0x6f, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x4b, 0x65, 0x79, 0x22, 0xc5, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x68, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55,
+	0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0x70, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0x32, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52,
+	0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0x59, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x25, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x4c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4c, 0x6f, 0x67, 0x22, 0xcf,
+	0x03, 0x0a, 0x05, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x6e, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43,
+	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x49, 0x4e, 0x47,
+	0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x45, 0x44, 0x10, 0x06,
+	0x22, 0x5f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x31, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x06, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x05,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x05, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x58, 0x0a, 0x12, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x05, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x05, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x2a, 0x24, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x01, 0x2a, 0x39, 0x0a, 0x0c,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
+	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x32, 0xec, 0x0f, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x0c,
+	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x49,
+	0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x14, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x54,
+	0x4c, 0x12, 0x1c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x10, 0x44, 0x69, 0x66, 0x66, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x18, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x25, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x0d, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x0e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x28, 0x0a, 0x06, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x0e, 0x2e, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0c, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x2e, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2b, 0x0a,
+	0x07, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x10, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x53, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x12, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x53, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x2e, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x3a, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x15, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x2b, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x0f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a,
+	0x06, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x0e, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x45, 0x6e, 0x76, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x76,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x11, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a,
+	0x09, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x11, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x44, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x23, 0x0a, 0x02, 0x55, 0x70, 0x12, 0x0a, 0x2e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0b, 0x2e, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x12,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x13, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x55, 0x72, 0x6c,
+	0x12, 0x11, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x55, 0x72, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x68, 0x65, 0x6c, 0x6d, 0x69, 0x63, 0x68, 0x2f, 0x68, 0x61,
+	0x69, 0x6b, 0x75, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cli_proto_rawDescOnce sync.Once
+	file_cli_proto_rawDescData = file_cli_proto_rawDesc
+)
+
+func file_cli_proto_rawDescGZIP() []byte {
+	file_cli_proto_rawDescOnce.Do(func() {
+		file_cli_proto_rawDescData = protoimpl.X.CompressGZIP(file_cli_proto_rawDescData)
+	})
+	return file_cli_proto_rawDescData
+}
+
+var file_cli_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_cli_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
+var file_cli_proto_goTypes = []interface{}{
+	(ImportMode)(0),                     // 0: ImportMode
+	(UploadStatus)(0),                   // 1: UploadStatus
+	(DeployEvent_DeployEventType)(0),    // 2: DeployEvent.DeployEventType
+	(RolloutUpdate_RolloutPhase)(0),     // 3: RolloutUpdate.RolloutPhase
+	(EnvChange_ChangeType)(0),           // 4: EnvChange.ChangeType
+	(DeploymentUpdate_StageStatus)(0),   // 5: DeploymentUpdate.StageStatus
+	(Build_BuildStatus)(0),              // 6: Build.BuildStatus
+	(*InitRequest)(nil),                 // 7: InitRequest
+	(*InitReply)(nil),                   // 8: InitReply
+	(*Environment)(nil),                 // 9: Environment
+	(*RegistryLogin)(nil),               // 10: RegistryLogin
+	(*ResourceUsage)(nil),               // 11: ResourceUsage
+	(*ServiceSpec)(nil),                 // 12: ServiceSpec
+	(*EnvironmentSpec)(nil),             // 13: EnvironmentSpec
+	(*ListEnvironmentsRequest)(nil),     // 14: ListEnvironmentsRequest
+	(*ListEnvironmentsReply)(nil),       // 15: ListEnvironmentsReply
+	(*DescribeEnvironmentRequest)(nil),  // 16: DescribeEnvironmentRequest
+	(*DescribeEnvironmentReply)(nil),    // 17: DescribeEnvironmentReply
+	(*SetEnvironmentTierRequest)(nil),   // 18: SetEnvironmentTierRequest
+	(*SetEnvironmentTierReply)(nil),     // 19: SetEnvironmentTierReply
+	(*CloneEnvironmentRequest)(nil),     // 20: CloneEnvironmentRequest
+	(*CloneEnvironmentReply)(nil),       // 21: CloneEnvironmentReply
+	(*ExtendEnvironmentTTLRequest)(nil), // 22: ExtendEnvironmentTTLRequest
+	(*ExtendEnvironmentTTLReply)(nil),   // 23: ExtendEnvironmentTTLReply
+	(*DiffEnvironmentsRequest)(nil),     // 24: DiffEnvironmentsRequest
+	(*FieldChange)(nil),                 // 25: FieldChange
+	(*ServiceDiff)(nil),                 // 26: ServiceDiff
+	(*RegistryLoginChange)(nil),         // 27: RegistryLoginChange
+	(*DiffEnvironmentsReply)(nil),       // 28: DiffEnvironmentsReply
+	(*ApplyRequest)(nil),                // 29: ApplyRequest
+	(*ApplyReply)(nil),                  // 30: ApplyReply
+	(*ExportRequest)(nil),               // 31: ExportRequest
+	(*ExportReply)(nil),                 // 32: ExportReply
+	(*DeleteEnvironmentRequest)(nil),    // 33: DeleteEnvironmentRequest
+	(*DeleteEnvironmentReply)(nil),      // 34: DeleteEnvironmentReply
+	(*TrafficTarget)(nil),               // 35: TrafficTarget
+	(*ServiceSettings)(nil),             // 36: ServiceSettings
+	(*DeployRequest)(nil),               // 37: DeployRequest
+	(*DeployReply)(nil),                 // 38: DeployReply
+	(*DeployEvent)(nil),                 // 39: DeployEvent
+	(*PromoteRequest)(nil),              // 40: PromoteRequest
+	(*PromoteReply)(nil),                // 41: PromoteReply
+	(*SetTrafficRequest)(nil),           // 42: SetTrafficRequest
+	(*SetTrafficReply)(nil),             // 43: SetTrafficReply
+	(*HealthCheck)(nil),                 // 44: HealthCheck
+	(*RolloutRequest)(nil),              // 45: RolloutRequest
+	(*RolloutUpdate)(nil),               // 46: RolloutUpdate
+	(*DeployRevision)(nil),              // 47: DeployRevision
+	(*ListRevisionsRequest)(nil),        // 48: ListRevisionsRequest
+	(*ListRevisionsReply)(nil),          // 49: ListRevisionsReply
+	(*RollbackRequest)(nil),             // 50: RollbackRequest
+	(*RollbackReply)(nil),               // 51: RollbackReply
+	(*ServiceInfo)(nil),                 // 52: ServiceInfo
+	(*ListServicesRequest)(nil),         // 53: ListServicesRequest
+	(*ListServicesReply)(nil),           // 54: ListServicesReply
+	(*GetServiceRequest)(nil),           // 55: GetServiceRequest
+	(*GetServiceReply)(nil),             // 56: GetServiceReply
+	(*DeleteServiceRequest)(nil),        // 57: DeleteServiceRequest
+	(*DeleteServiceReply)(nil),          // 58: DeleteServiceReply
+	(*ListEnvRequest)(nil),              // 59: ListEnvRequest
+	(*ListEnvReply)(nil),                // 60: ListEnvReply
+	(*SetEnvRequest)(nil),               // 61: SetEnvRequest
+	(*SetEnvReply)(nil),                 // 62: SetEnvReply
+	(*RemoveEnvRequest)(nil),            // 63: RemoveEnvRequest
+	(*RemoveEnvReply)(nil),              // 64: RemoveEnvReply
+	(*ImportEnvRequest)(nil),            // 65: ImportEnvRequest
+	(*ImportEnvReply)(nil),              // 66: ImportEnvReply
+	(*ExportEnvRequest)(nil),            // 67: ExportEnvRequest
+	(*ExportEnvReply)(nil),              // 68: ExportEnvReply
+	(*EnvChange)(nil),                   // 69: EnvChange
+	(*EnvRevision)(nil),                 // 70: EnvRevision
+	(*ListEnvRevisionsRequest)(nil),     // 71: ListEnvRevisionsRequest
+	(*ListEnvRevisionsReply)(nil),       // 72: ListEnvRevisionsReply
+	(*RestoreEnvRevisionRequest)(nil),   // 73: RestoreEnvRevisionRequest
+	(*RestoreEnvRevisionReply)(nil),     // 74: RestoreEnvRevisionReply
+	(*DockerLoginRequest)(nil),          // 75: DockerLoginRequest
+	(*DockerLoginReply)(nil),            // 76: DockerLoginReply
+	(*MetaData)(nil),                    // 77: MetaData
+	(*UpRequest)(nil),                   // 78: UpRequest
+	(*DeploymentUpdate)(nil),            // 79: DeploymentUpdate
+	(*UploadProgress)(nil),              // 80: UploadProgress
+	(*UpResponse)(nil),                  // 81: UpResponse
+	(*GetServiceUploadUrlRequest)(nil),  // 82: GetServiceUploadUrlRequest
+	(*GetServiceUploadUrlResponse)(nil), // 83: GetServiceUploadUrlResponse
+	(*DeployUrlRequest)(nil),            // 84: DeployUrlRequest
+	(*DeployUrlReply)(nil),              // 85: DeployUrlReply
+	(*GetBuildLogsRequest)(nil),         // 86: GetBuildLogsRequest
+	(*GetBuildLogsReply)(nil),           // 87: GetBuildLogsReply
+	(*Build)(nil),                       // 88: Build
+	(*ListBuildsRequest)(nil),           // 89: ListBuildsRequest
+	(*ListBuildsReply)(nil),             // 90: ListBuildsReply
+	(*GetBuildRequest)(nil),             // 91: GetBuildRequest
+	(*GetBuildReply)(nil),               // 92: GetBuildReply
+	(*CancelBuildRequest)(nil),          // 93: CancelBuildRequest
+	(*CancelBuildReply)(nil),            // 94: CancelBuildReply
+	nil,                                 // 95: ServiceSpec.EnvEntry
+	nil,                                 // 96: ServiceSpec.SecretEnvEntry
+	nil,                                 // 97: DescribeEnvironmentReply.EnvCountsEntry
+	nil,                                 // 98: DescribeEnvironmentReply.SecretEnvCountsEntry
+	nil,                                 // 99: CloneEnvironmentRequest.ImageOverridesEntry
+	(*ListEnvReply_KeyValue)(nil),       // 100: ListEnvReply.KeyValue
+}
+var file_cli_proto_depIdxs = []int32{
+	95,  // 0: ServiceSpec.Env:type_name -> ServiceSpec.EnvEntry
+	96,  // 1: ServiceSpec.SecretEnv:type_name -> ServiceSpec.SecretEnvEntry
+	36,  // 2: ServiceSpec.Settings:type_name -> ServiceSettings
+	12,  // 3: EnvironmentSpec.Services:type_name -> ServiceSpec
+	10,  // 4: EnvironmentSpec.RegistryLogins:type_name -> RegistryLogin
+	9,   // 5: ListEnvironmentsReply.Environments:type_name -> Environment
+	9,   // 6: DescribeEnvironmentReply.Environment:type_name -> Environment
+	52,  // 7: DescribeEnvironmentReply.Services:type_name -> ServiceInfo
+	10,  // 8: DescribeEnvironmentReply.RegistryLogins:type_name -> RegistryLogin
+	97,  // 9: DescribeEnvironmentReply.EnvCounts:type_name -> DescribeEnvironmentReply.EnvCountsEntry
+	98,  // 10: DescribeEnvironmentReply.SecretEnvCounts:type_name -> DescribeEnvironmentReply.SecretEnvCountsEntry
+	11,  // 11: DescribeEnvironmentReply.Usage:type_name -> ResourceUsage
+	9,   // 12: SetEnvironmentTierReply.Environment:type_name -> Environment
+	99,  // 13: CloneEnvironmentRequest.ImageOverrides:type_name -> CloneEnvironmentRequest.ImageOverridesEntry
+	52,  // 14: CloneEnvironmentReply.Services:type_name -> ServiceInfo
+	9,   // 15: ExtendEnvironmentTTLReply.Environment:type_name -> Environment
+	13,  // 16: DiffEnvironmentsRequest.Spec:type_name -> EnvironmentSpec
+	4,   // 17: ServiceDiff.Type:type_name -> EnvChange.ChangeType
+	25,  // 18: ServiceDiff.Fields:type_name -> FieldChange
+	69,  // 19: ServiceDiff.Env:type_name -> EnvChange
+	4,   // 20: RegistryLoginChange.Type:type_name -> EnvChange.ChangeType
+	26,  // 21: DiffEnvironmentsReply.Services:type_name -> ServiceDiff
+	27,  // 22: DiffEnvironmentsReply.RegistryLogins:type_name -> RegistryLoginChange
+	28,  // 23: ApplyReply.Diff:type_name -> DiffEnvironmentsReply
+	52,  // 24: ApplyReply.Services:type_name -> ServiceInfo
+	35,  // 25: DeployRequest.Traffic:type_name -> TrafficTarget
+	36,  // 26: DeployRequest.Settings:type_name -> ServiceSettings
+	35,  // 27: DeployReply.Traffic:type_name -> TrafficTarget
+	2,   // 28: DeployEvent.Type:type_name -> DeployEvent.DeployEventType
+	35,  // 29: SetTrafficRequest.Traffic:type_name -> TrafficTarget
+	35,  // 30: SetTrafficReply.Traffic:type_name -> TrafficTarget
+	44,  // 31: RolloutRequest.HealthCheck:type_name -> HealthCheck
+	3,   // 32: RolloutUpdate.Phase:type_name -> RolloutUpdate.RolloutPhase
+	47,  // 33: ListRevisionsReply.Revisions:type_name -> DeployRevision
+	36,  // 34: ServiceInfo.Settings:type_name -> ServiceSettings
+	35,  // 35: ServiceInfo.Traffic:type_name -> TrafficTarget
+	52,  // 36: ListServicesReply.Services:type_name -> ServiceInfo
+	52,  // 37: GetServiceReply.Service:type_name -> ServiceInfo
+	100, // 38: ListEnvReply.List:type_name -> ListEnvReply.KeyValue
+	0,   // 39: ImportEnvRequest.Mode:type_name -> ImportMode
+	4,   // 40: EnvChange.Type:type_name -> EnvChange.ChangeType
+	69,  // 41: EnvRevision.Changes:type_name -> EnvChange
+	70,  // 42: ListEnvRevisionsReply.Revisions:type_name -> EnvRevision
+	77,  // 43: UpRequest.MetaData:type_name -> MetaData
+	5,   // 44: DeploymentUpdate.Status:type_name -> DeploymentUpdate.StageStatus
+	1,   // 45: UploadProgress.Status:type_name -> UploadStatus
+	1,   // 46: UpResponse.UploadStatus:type_name -> UploadStatus
+	79,  // 47: UpResponse.DeploymentUpdate:type_name -> DeploymentUpdate
+	80,  // 48: UpResponse.UploadProgress:type_name -> UploadProgress
+	6,   // 49: Build.Status:type_name -> Build.BuildStatus
+	88,  // 50: ListBuildsReply.Builds:type_name -> Build
+	88,  // 51: GetBuildReply.Build:type_name -> Build
+	88,  // 52: CancelBuildReply.Build:type_name -> Build
+	7,   // 53: CliService.Init:input_type -> InitRequest
+	14,  // 54: CliService.ListEnvironments:input_type -> ListEnvironmentsRequest
+	16,  // 55: CliService.DescribeEnvironment:input_type -> DescribeEnvironmentRequest
+	18,  // 56: CliService.SetEnvironmentTier:input_type -> SetEnvironmentTierRequest
+	20,  // 57: CliService.CloneEnvironment:input_type -> CloneEnvironmentRequest
+	22,  // 58: CliService.ExtendEnvironmentTTL:input_type -> ExtendEnvironmentTTLRequest
+	24,  // 59: CliService.DiffEnvironments:input_type -> DiffEnvironmentsRequest
+	33,  // 60: CliService.DeleteEnvironment:input_type -> DeleteEnvironmentRequest
+	29,  // 61: CliService.Apply:input_type -> ApplyRequest
+	31,  // 62: CliService.Export:input_type -> ExportRequest
+	37,  // 63: CliService.Deploy:input_type -> DeployRequest
+	37,  // 64: CliService.DeployStream:input_type -> DeployRequest
+	40,  // 65: CliService.Promote:input_type -> PromoteRequest
+	48,  // 66: CliService.ListRevisions:input_type -> ListRevisionsRequest
+	50,  // 67: CliService.Rollback:input_type -> RollbackRequest
+	42,  // 68: CliService.SetTraffic:input_type -> SetTrafficRequest
+	45,  // 69: CliService.Rollout:input_type -> RolloutRequest
+	53,  // 70: CliService.ListServices:input_type -> ListServicesRequest
+	55,  // 71: CliService.GetService:input_type -> GetServiceRequest
+	57,  // 72: CliService.DeleteService:input_type -> DeleteServiceRequest
+	59,  // 73: CliService.ListEnv:input_type -> ListEnvRequest
+	61,  // 74: CliService.SetEnv:input_type -> SetEnvRequest
+	63,  // 75: CliService.RemoveEnv:input_type -> RemoveEnvRequest
+	65,  // 76: CliService.ImportEnv:input_type -> ImportEnvRequest
+	67,  // 77: CliService.ExportEnv:input_type -> ExportEnvRequest
+	71,  // 78: CliService.ListEnvRevisions:input_type -> ListEnvRevisionsRequest
+	73,  // 79: CliService.RestoreEnvRevision:input_type -> RestoreEnvRevisionRequest
+	75,  // 80: CliService.DockerLogin:input_type -> DockerLoginRequest
+	78,  // 81: CliService.Up:input_type -> UpRequest
+	86,  // 82: CliService.GetBuildLogs:input_type -> GetBuildLogsRequest
+	89,  // 83: CliService.ListBuilds:input_type -> ListBuildsRequest
+	91,  // 84: CliService.GetBuild:input_type -> GetBuildRequest
+	93,  // 85: CliService.CancelBuild:input_type -> CancelBuildRequest
+	82,  // 86: CliService.GetServiceUploadUrl:input_type -> GetServiceUploadUrlRequest
+	84,  // 87: CliService.DeployUrl:input_type -> DeployUrlRequest
+	8,   // 88: CliService.Init:output_type -> InitReply
+	15,  // 89: CliService.ListEnvironments:output_type -> ListEnvironmentsReply
+	17,  // 90: CliService.DescribeEnvironment:output_type -> DescribeEnvironmentReply
+	19,  // 91: CliService.SetEnvironmentTier:output_type -> SetEnvironmentTierReply
+	21,  // 92: CliService.CloneEnvironment:output_type -> CloneEnvironmentReply
+	23,  // 93: CliService.ExtendEnvironmentTTL:output_type -> ExtendEnvironmentTTLReply
+	28,  // 94: CliService.DiffEnvironments:output_type -> DiffEnvironmentsReply
+	34,  // 95: CliService.DeleteEnvironment:output_type -> DeleteEnvironmentReply
+	30,  // 96: CliService.Apply:output_type -> ApplyReply
+	32,  // 97: CliService.Export:output_type -> ExportReply
+	38,  // 98: CliService.Deploy:output_type -> DeployReply
+	39,  // 99: CliService.DeployStream:output_type -> DeployEvent
+	41,  // 100: CliService.Promote:output_type -> PromoteReply
+	49,  // 101: CliService.ListRevisions:output_type -> ListRevisionsReply
+	51,  // 102: CliService.Rollback:output_type -> RollbackReply
+	43,  // 103: CliService.SetTraffic:output_type -> SetTrafficReply
+	46,  // 104: CliService.Rollout:output_type -> RolloutUpdate
+	54,  // 105: CliService.ListServices:output_type -> ListServicesReply
+	56,  // 106: CliService.GetService:output_type -> GetServiceReply
+	58,  // 107: CliService.DeleteService:output_type -> DeleteServiceReply
+	60,  // 108: CliService.ListEnv:output_type -> ListEnvReply
+	62,  // 109: CliService.SetEnv:output_type -> SetEnvReply
+	64,  // 110: CliService.RemoveEnv:output_type -> RemoveEnvReply
+	66,  // 111: CliService.ImportEnv:output_type -> ImportEnvReply
+	68,  // 112: CliService.ExportEnv:output_type -> ExportEnvReply
+	72,  // 113: CliService.ListEnvRevisions:output_type -> ListEnvRevisionsReply
+	74,  // 114: CliService.RestoreEnvRevision:output_type -> RestoreEnvRevisionReply
+	76,  // 115: CliService.DockerLogin:output_type -> DockerLoginReply
+	81,  // 116: CliService.Up:output_type -> UpResponse
+	87,  // 117: CliService.GetBuildLogs:output_type -> GetBuildLogsReply
+	90,  // 118: CliService.ListBuilds:output_type -> ListBuildsReply
+	92,  // 119: CliService.GetBuild:output_type -> GetBuildReply
+	94,  // 120: CliService.CancelBuild:output_type -> CancelBuildReply
+	83,  // 121: CliService.GetServiceUploadUrl:output_type -> GetServiceUploadUrlResponse
+	85,  // 122: CliService.DeployUrl:output_type -> DeployUrlReply
+	88,  // [88:123] is the sub-list for method output_type
+	53,  // [53:88] is the sub-list for method input_type
+	53,  // [53:53] is the sub-list for extension type_name
+	53,  // [53:53] is the sub-list for extension extendee
+	0,   // [0:53] is the sub-list for field type_name
+}
+
+func init() { file_cli_proto_init() }
+func file_cli_proto_init() {
+	if File_cli_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cli_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 		file_cli_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InitReply); i {
 			case 0:
@@ -1496,8 +7316,548 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployRequest); i {
+		file_cli_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Environment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnvironmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnvironmentsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeEnvironmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEnvironmentTierRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEnvironmentTierReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneEnvironmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendEnvironmentTTLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendEnvironmentTTLReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffEnvironmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryLoginChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffEnvironmentsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEnvironmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTrafficRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTrafficReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevisionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServicesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1508,8 +7868,8 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployReply); i {
+		file_cli_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1520,7 +7880,43 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteServiceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEnvRequest); i {
 			case 0:
 				return &v.state
@@ -1532,7 +7928,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEnvReply); i {
 			case 0:
 				return &v.state
@@ -1544,7 +7940,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetEnvRequest); i {
 			case 0:
 				return &v.state
@@ -1556,7 +7952,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetEnvReply); i {
 			case 0:
 				return &v.state
@@ -1568,7 +7964,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveEnvRequest); i {
 			case 0:
 				return &v.state
@@ -1580,7 +7976,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveEnvReply); i {
 			case 0:
 				return &v.state
@@ -1592,7 +7988,127 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEnvRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEnvReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEnvRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEnvReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnvRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnvRevisionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreEnvRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreEnvRevisionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DockerLoginRequest); i {
 			case 0:
 				return &v.state
@@ -1604,7 +8120,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DockerLoginReply); i {
 			case 0:
 				return &v.state
@@ -1616,7 +8132,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetaData); i {
 			case 0:
 				return &v.state
@@ -1628,7 +8144,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpRequest); i {
 			case 0:
 				return &v.state
@@ -1640,7 +8156,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeploymentUpdate); i {
 			case 0:
 				return &v.state
@@ -1652,7 +8168,19 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpResponse); i {
 			case 0:
 				return &v.state
@@ -1664,7 +8192,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetServiceUploadUrlRequest); i {
 			case 0:
 				return &v.state
@@ -1676,7 +8204,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetServiceUploadUrlResponse); i {
 			case 0:
 				return &v.state
@@ -1688,7 +8216,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeployUrlRequest); i {
 			case 0:
 				return &v.state
@@ -1700,7 +8228,7 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeployUrlReply); i {
 			case 0:
 				return &v.state
@@ -1712,7 +8240,115 @@ func file_cli_proto_init() {
 				return nil
 			}
 		}
-		file_cli_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_cli_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuildLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuildLogsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Build); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBuildsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBuildsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuildRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuildReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBuildRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBuildReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEnvReply_KeyValue); i {
 			case 0:
 				return &v.state
@@ -1725,21 +8361,26 @@ func file_cli_proto_init() {
 			}
 		}
 	}
-	file_cli_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_cli_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*DiffEnvironmentsRequest_OtherEnvironmentName)(nil),
+		(*DiffEnvironmentsRequest_Spec)(nil),
+	}
+	file_cli_proto_msgTypes[71].OneofWrappers = []interface{}{
 		(*UpRequest_MetaData)(nil),
 		(*UpRequest_Chunk)(nil),
 	}
-	file_cli_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_cli_proto_msgTypes[74].OneofWrappers = []interface{}{
 		(*UpResponse_UploadStatus)(nil),
 		(*UpResponse_DeploymentUpdate)(nil),
+		(*UpResponse_UploadProgress)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cli_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   21,
+			NumEnums:      7,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
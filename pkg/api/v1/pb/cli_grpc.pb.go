@@ -19,12 +19,38 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CliServiceClient interface {
 	Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitReply, error)
+	ListEnvironments(ctx context.Context, in *ListEnvironmentsRequest, opts ...grpc.CallOption) (*ListEnvironmentsReply, error)
+	DescribeEnvironment(ctx context.Context, in *DescribeEnvironmentRequest, opts ...grpc.CallOption) (*DescribeEnvironmentReply, error)
+	SetEnvironmentTier(ctx context.Context, in *SetEnvironmentTierRequest, opts ...grpc.CallOption) (*SetEnvironmentTierReply, error)
+	CloneEnvironment(ctx context.Context, in *CloneEnvironmentRequest, opts ...grpc.CallOption) (*CloneEnvironmentReply, error)
+	ExtendEnvironmentTTL(ctx context.Context, in *ExtendEnvironmentTTLRequest, opts ...grpc.CallOption) (*ExtendEnvironmentTTLReply, error)
+	DiffEnvironments(ctx context.Context, in *DiffEnvironmentsRequest, opts ...grpc.CallOption) (*DiffEnvironmentsReply, error)
+	DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentRequest, opts ...grpc.CallOption) (*DeleteEnvironmentReply, error)
+	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyReply, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportReply, error)
 	Deploy(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (*DeployReply, error)
+	DeployStream(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (CliService_DeployStreamClient, error)
+	Promote(ctx context.Context, in *PromoteRequest, opts ...grpc.CallOption) (*PromoteReply, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsReply, error)
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackReply, error)
+	SetTraffic(ctx context.Context, in *SetTrafficRequest, opts ...grpc.CallOption) (*SetTrafficReply, error)
+	Rollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (CliService_RolloutClient, error)
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesReply, error)
+	GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*GetServiceReply, error)
+	DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceReply, error)
 	ListEnv(ctx context.Context, in *ListEnvRequest, opts ...grpc.CallOption) (*ListEnvReply, error)
 	SetEnv(ctx context.Context, in *SetEnvRequest, opts ...grpc.CallOption) (*SetEnvReply, error)
 	RemoveEnv(ctx context.Context, in *RemoveEnvRequest, opts ...grpc.CallOption) (*RemoveEnvReply, error)
+	ImportEnv(ctx context.Context, in *ImportEnvRequest, opts ...grpc.CallOption) (*ImportEnvReply, error)
+	ExportEnv(ctx context.Context, in *ExportEnvRequest, opts ...grpc.CallOption) (*ExportEnvReply, error)
+	ListEnvRevisions(ctx context.Context, in *ListEnvRevisionsRequest, opts ...grpc.CallOption) (*ListEnvRevisionsReply, error)
+	RestoreEnvRevision(ctx context.Context, in *RestoreEnvRevisionRequest, opts ...grpc.CallOption) (*RestoreEnvRevisionReply, error)
 	DockerLogin(ctx context.Context, in *DockerLoginRequest, opts ...grpc.CallOption) (*DockerLoginReply, error)
 	Up(ctx context.Context, opts ...grpc.CallOption) (CliService_UpClient, error)
+	GetBuildLogs(ctx context.Context, in *GetBuildLogsRequest, opts ...grpc.CallOption) (*GetBuildLogsReply, error)
+	ListBuilds(ctx context.Context, in *ListBuildsRequest, opts ...grpc.CallOption) (*ListBuildsReply, error)
+	GetBuild(ctx context.Context, in *GetBuildRequest, opts ...grpc.CallOption) (*GetBuildReply, error)
+	CancelBuild(ctx context.Context, in *CancelBuildRequest, opts ...grpc.CallOption) (*CancelBuildReply, error)
 	GetServiceUploadUrl(ctx context.Context, in *GetServiceUploadUrlRequest, opts ...grpc.CallOption) (*GetServiceUploadUrlResponse, error)
 	DeployUrl(ctx context.Context, in *DeployUrlRequest, opts ...grpc.CallOption) (*DeployUrlReply, error)
 }
@@ -46,6 +72,87 @@ func (c *cliServiceClient) Init(ctx context.Context, in *InitRequest, opts ...gr
 	return out, nil
 }
 
+func (c *cliServiceClient) ListEnvironments(ctx context.Context, in *ListEnvironmentsRequest, opts ...grpc.CallOption) (*ListEnvironmentsReply, error) {
+	out := new(ListEnvironmentsReply)
+	err := c.cc.Invoke(ctx, "/CliService/ListEnvironments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) DescribeEnvironment(ctx context.Context, in *DescribeEnvironmentRequest, opts ...grpc.CallOption) (*DescribeEnvironmentReply, error) {
+	out := new(DescribeEnvironmentReply)
+	err := c.cc.Invoke(ctx, "/CliService/DescribeEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) SetEnvironmentTier(ctx context.Context, in *SetEnvironmentTierRequest, opts ...grpc.CallOption) (*SetEnvironmentTierReply, error) {
+	out := new(SetEnvironmentTierReply)
+	err := c.cc.Invoke(ctx, "/CliService/SetEnvironmentTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) CloneEnvironment(ctx context.Context, in *CloneEnvironmentRequest, opts ...grpc.CallOption) (*CloneEnvironmentReply, error) {
+	out := new(CloneEnvironmentReply)
+	err := c.cc.Invoke(ctx, "/CliService/CloneEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) ExtendEnvironmentTTL(ctx context.Context, in *ExtendEnvironmentTTLRequest, opts ...grpc.CallOption) (*ExtendEnvironmentTTLReply, error) {
+	out := new(ExtendEnvironmentTTLReply)
+	err := c.cc.Invoke(ctx, "/CliService/ExtendEnvironmentTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) DiffEnvironments(ctx context.Context, in *DiffEnvironmentsRequest, opts ...grpc.CallOption) (*DiffEnvironmentsReply, error) {
+	out := new(DiffEnvironmentsReply)
+	err := c.cc.Invoke(ctx, "/CliService/DiffEnvironments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentRequest, opts ...grpc.CallOption) (*DeleteEnvironmentReply, error) {
+	out := new(DeleteEnvironmentReply)
+	err := c.cc.Invoke(ctx, "/CliService/DeleteEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyReply, error) {
+	out := new(ApplyReply)
+	err := c.cc.Invoke(ctx, "/CliService/Apply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportReply, error) {
+	out := new(ExportReply)
+	err := c.cc.Invoke(ctx, "/CliService/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cliServiceClient) Deploy(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (*DeployReply, error) {
 	out := new(DeployReply)
 	err := c.cc.Invoke(ctx, "/CliService/Deploy", in, out, opts...)
@@ -55,6 +162,133 @@ func (c *cliServiceClient) Deploy(ctx context.Context, in *DeployRequest, opts .
 	return out, nil
 }
 
+func (c *cliServiceClient) DeployStream(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (CliService_DeployStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CliService_ServiceDesc.Streams[0], "/CliService/DeployStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cliServiceDeployStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CliService_DeployStreamClient interface {
+	Recv() (*DeployEvent, error)
+	grpc.ClientStream
+}
+
+type cliServiceDeployStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *cliServiceDeployStreamClient) Recv() (*DeployEvent, error) {
+	m := new(DeployEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cliServiceClient) Promote(ctx context.Context, in *PromoteRequest, opts ...grpc.CallOption) (*PromoteReply, error) {
+	out := new(PromoteReply)
+	err := c.cc.Invoke(ctx, "/CliService/Promote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsReply, error) {
+	out := new(ListRevisionsReply)
+	err := c.cc.Invoke(ctx, "/CliService/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackReply, error) {
+	out := new(RollbackReply)
+	err := c.cc.Invoke(ctx, "/CliService/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) SetTraffic(ctx context.Context, in *SetTrafficRequest, opts ...grpc.CallOption) (*SetTrafficReply, error) {
+	out := new(SetTrafficReply)
+	err := c.cc.Invoke(ctx, "/CliService/SetTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) Rollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (CliService_RolloutClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CliService_ServiceDesc.Streams[1], "/CliService/Rollout", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cliServiceRolloutClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CliService_RolloutClient interface {
+	Recv() (*RolloutUpdate, error)
+	grpc.ClientStream
+}
+
+type cliServiceRolloutClient struct {
+	grpc.ClientStream
+}
+
+func (x *cliServiceRolloutClient) Recv() (*RolloutUpdate, error) {
+	m := new(RolloutUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cliServiceClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesReply, error) {
+	out := new(ListServicesReply)
+	err := c.cc.Invoke(ctx, "/CliService/ListServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*GetServiceReply, error) {
+	out := new(GetServiceReply)
+	err := c.cc.Invoke(ctx, "/CliService/GetService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceReply, error) {
+	out := new(DeleteServiceReply)
+	err := c.cc.Invoke(ctx, "/CliService/DeleteService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cliServiceClient) ListEnv(ctx context.Context, in *ListEnvRequest, opts ...grpc.CallOption) (*ListEnvReply, error) {
 	out := new(ListEnvReply)
 	err := c.cc.Invoke(ctx, "/CliService/ListEnv", in, out, opts...)
@@ -82,6 +316,42 @@ func (c *cliServiceClient) RemoveEnv(ctx context.Context, in *RemoveEnvRequest,
 	return out, nil
 }
 
+func (c *cliServiceClient) ImportEnv(ctx context.Context, in *ImportEnvRequest, opts ...grpc.CallOption) (*ImportEnvReply, error) {
+	out := new(ImportEnvReply)
+	err := c.cc.Invoke(ctx, "/CliService/ImportEnv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) ExportEnv(ctx context.Context, in *ExportEnvRequest, opts ...grpc.CallOption) (*ExportEnvReply, error) {
+	out := new(ExportEnvReply)
+	err := c.cc.Invoke(ctx, "/CliService/ExportEnv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) ListEnvRevisions(ctx context.Context, in *ListEnvRevisionsRequest, opts ...grpc.CallOption) (*ListEnvRevisionsReply, error) {
+	out := new(ListEnvRevisionsReply)
+	err := c.cc.Invoke(ctx, "/CliService/ListEnvRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) RestoreEnvRevision(ctx context.Context, in *RestoreEnvRevisionRequest, opts ...grpc.CallOption) (*RestoreEnvRevisionReply, error) {
+	out := new(RestoreEnvRevisionReply)
+	err := c.cc.Invoke(ctx, "/CliService/RestoreEnvRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cliServiceClient) DockerLogin(ctx context.Context, in *DockerLoginRequest, opts ...grpc.CallOption) (*DockerLoginReply, error) {
 	out := new(DockerLoginReply)
 	err := c.cc.Invoke(ctx, "/CliService/DockerLogin", in, out, opts...)
@@ -92,7 +362,7 @@ func (c *cliServiceClient) DockerLogin(ctx context.Context, in *DockerLoginReque
 }
 
 func (c *cliServiceClient) Up(ctx context.Context, opts ...grpc.CallOption) (CliService_UpClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CliService_ServiceDesc.Streams[0], "/CliService/Up", opts...)
+	stream, err := c.cc.NewStream(ctx, &CliService_ServiceDesc.Streams[2], "/CliService/Up", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +392,42 @@ func (x *cliServiceUpClient) Recv() (*UpResponse, error) {
 	return m, nil
 }
 
+func (c *cliServiceClient) GetBuildLogs(ctx context.Context, in *GetBuildLogsRequest, opts ...grpc.CallOption) (*GetBuildLogsReply, error) {
+	out := new(GetBuildLogsReply)
+	err := c.cc.Invoke(ctx, "/CliService/GetBuildLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) ListBuilds(ctx context.Context, in *ListBuildsRequest, opts ...grpc.CallOption) (*ListBuildsReply, error) {
+	out := new(ListBuildsReply)
+	err := c.cc.Invoke(ctx, "/CliService/ListBuilds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) GetBuild(ctx context.Context, in *GetBuildRequest, opts ...grpc.CallOption) (*GetBuildReply, error) {
+	out := new(GetBuildReply)
+	err := c.cc.Invoke(ctx, "/CliService/GetBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliServiceClient) CancelBuild(ctx context.Context, in *CancelBuildRequest, opts ...grpc.CallOption) (*CancelBuildReply, error) {
+	out := new(CancelBuildReply)
+	err := c.cc.Invoke(ctx, "/CliService/CancelBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cliServiceClient) GetServiceUploadUrl(ctx context.Context, in *GetServiceUploadUrlRequest, opts ...grpc.CallOption) (*GetServiceUploadUrlResponse, error) {
 	out := new(GetServiceUploadUrlResponse)
 	err := c.cc.Invoke(ctx, "/CliService/GetServiceUploadUrl", in, out, opts...)
@@ -145,12 +451,38 @@ func (c *cliServiceClient) DeployUrl(ctx context.Context, in *DeployUrlRequest,
 // for forward compatibility
 type CliServiceServer interface {
 	Init(context.Context, *InitRequest) (*InitReply, error)
+	ListEnvironments(context.Context, *ListEnvironmentsRequest) (*ListEnvironmentsReply, error)
+	DescribeEnvironment(context.Context, *DescribeEnvironmentRequest) (*DescribeEnvironmentReply, error)
+	SetEnvironmentTier(context.Context, *SetEnvironmentTierRequest) (*SetEnvironmentTierReply, error)
+	CloneEnvironment(context.Context, *CloneEnvironmentRequest) (*CloneEnvironmentReply, error)
+	ExtendEnvironmentTTL(context.Context, *ExtendEnvironmentTTLRequest) (*ExtendEnvironmentTTLReply, error)
+	DiffEnvironments(context.Context, *DiffEnvironmentsRequest) (*DiffEnvironmentsReply, error)
+	DeleteEnvironment(context.Context, *DeleteEnvironmentRequest) (*DeleteEnvironmentReply, error)
+	Apply(context.Context, *ApplyRequest) (*ApplyReply, error)
+	Export(context.Context, *ExportRequest) (*ExportReply, error)
 	Deploy(context.Context, *DeployRequest) (*DeployReply, error)
+	DeployStream(*DeployRequest, CliService_DeployStreamServer) error
+	Promote(context.Context, *PromoteRequest) (*PromoteReply, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsReply, error)
+	Rollback(context.Context, *RollbackRequest) (*RollbackReply, error)
+	SetTraffic(context.Context, *SetTrafficRequest) (*SetTrafficReply, error)
+	Rollout(*RolloutRequest, CliService_RolloutServer) error
+	ListServices(context.Context, *ListServicesRequest) (*ListServicesReply, error)
+	GetService(context.Context, *GetServiceRequest) (*GetServiceReply, error)
+	DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceReply, error)
 	ListEnv(context.Context, *ListEnvRequest) (*ListEnvReply, error)
 	SetEnv(context.Context, *SetEnvRequest) (*SetEnvReply, error)
 	RemoveEnv(context.Context, *RemoveEnvRequest) (*RemoveEnvReply, error)
+	ImportEnv(context.Context, *ImportEnvRequest) (*ImportEnvReply, error)
+	ExportEnv(context.Context, *ExportEnvRequest) (*ExportEnvReply, error)
+	ListEnvRevisions(context.Context, *ListEnvRevisionsRequest) (*ListEnvRevisionsReply, error)
+	RestoreEnvRevision(context.Context, *RestoreEnvRevisionRequest) (*RestoreEnvRevisionReply, error)
 	DockerLogin(context.Context, *DockerLoginRequest) (*DockerLoginReply, error)
 	Up(CliService_UpServer) error
+	GetBuildLogs(context.Context, *GetBuildLogsRequest) (*GetBuildLogsReply, error)
+	ListBuilds(context.Context, *ListBuildsRequest) (*ListBuildsReply, error)
+	GetBuild(context.Context, *GetBuildRequest) (*GetBuildReply, error)
+	CancelBuild(context.Context, *CancelBuildRequest) (*CancelBuildReply, error)
 	GetServiceUploadUrl(context.Context, *GetServiceUploadUrlRequest) (*GetServiceUploadUrlResponse, error)
 	DeployUrl(context.Context, *DeployUrlRequest) (*DeployUrlReply, error)
 	mustEmbedUnimplementedCliServiceServer()
@@ -163,9 +495,63 @@ type UnimplementedCliServiceServer struct {
 func (UnimplementedCliServiceServer) Init(context.Context, *InitRequest) (*InitReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
 }
+func (UnimplementedCliServiceServer) ListEnvironments(context.Context, *ListEnvironmentsRequest) (*ListEnvironmentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnvironments not implemented")
+}
+func (UnimplementedCliServiceServer) DescribeEnvironment(context.Context, *DescribeEnvironmentRequest) (*DescribeEnvironmentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeEnvironment not implemented")
+}
+func (UnimplementedCliServiceServer) SetEnvironmentTier(context.Context, *SetEnvironmentTierRequest) (*SetEnvironmentTierReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnvironmentTier not implemented")
+}
+func (UnimplementedCliServiceServer) CloneEnvironment(context.Context, *CloneEnvironmentRequest) (*CloneEnvironmentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneEnvironment not implemented")
+}
+func (UnimplementedCliServiceServer) ExtendEnvironmentTTL(context.Context, *ExtendEnvironmentTTLRequest) (*ExtendEnvironmentTTLReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendEnvironmentTTL not implemented")
+}
+func (UnimplementedCliServiceServer) DiffEnvironments(context.Context, *DiffEnvironmentsRequest) (*DiffEnvironmentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffEnvironments not implemented")
+}
+func (UnimplementedCliServiceServer) DeleteEnvironment(context.Context, *DeleteEnvironmentRequest) (*DeleteEnvironmentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironment not implemented")
+}
+func (UnimplementedCliServiceServer) Apply(context.Context, *ApplyRequest) (*ApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+}
+func (UnimplementedCliServiceServer) Export(context.Context, *ExportRequest) (*ExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedCliServiceServer) Deploy(context.Context, *DeployRequest) (*DeployReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deploy not implemented")
 }
+func (UnimplementedCliServiceServer) DeployStream(*DeployRequest, CliService_DeployStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DeployStream not implemented")
+}
+func (UnimplementedCliServiceServer) Promote(context.Context, *PromoteRequest) (*PromoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promote not implemented")
+}
+func (UnimplementedCliServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedCliServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedCliServiceServer) SetTraffic(context.Context, *SetTrafficRequest) (*SetTrafficReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTraffic not implemented")
+}
+func (UnimplementedCliServiceServer) Rollout(*RolloutRequest, CliService_RolloutServer) error {
+	return status.Errorf(codes.Unimplemented, "method Rollout not implemented")
+}
+func (UnimplementedCliServiceServer) ListServices(context.Context, *ListServicesRequest) (*ListServicesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
+}
+func (UnimplementedCliServiceServer) GetService(context.Context, *GetServiceRequest) (*GetServiceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetService not implemented")
+}
+func (UnimplementedCliServiceServer) DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
+}
 func (UnimplementedCliServiceServer) ListEnv(context.Context, *ListEnvRequest) (*ListEnvReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEnv not implemented")
 }
@@ -175,12 +561,36 @@ func (UnimplementedCliServiceServer) SetEnv(context.Context, *SetEnvRequest) (*S
 func (UnimplementedCliServiceServer) RemoveEnv(context.Context, *RemoveEnvRequest) (*RemoveEnvReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveEnv not implemented")
 }
+func (UnimplementedCliServiceServer) ImportEnv(context.Context, *ImportEnvRequest) (*ImportEnvReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEnv not implemented")
+}
+func (UnimplementedCliServiceServer) ExportEnv(context.Context, *ExportEnvRequest) (*ExportEnvReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEnv not implemented")
+}
+func (UnimplementedCliServiceServer) ListEnvRevisions(context.Context, *ListEnvRevisionsRequest) (*ListEnvRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnvRevisions not implemented")
+}
+func (UnimplementedCliServiceServer) RestoreEnvRevision(context.Context, *RestoreEnvRevisionRequest) (*RestoreEnvRevisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEnvRevision not implemented")
+}
 func (UnimplementedCliServiceServer) DockerLogin(context.Context, *DockerLoginRequest) (*DockerLoginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DockerLogin not implemented")
 }
 func (UnimplementedCliServiceServer) Up(CliService_UpServer) error {
 	return status.Errorf(codes.Unimplemented, "method Up not implemented")
 }
+func (UnimplementedCliServiceServer) GetBuildLogs(context.Context, *GetBuildLogsRequest) (*GetBuildLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildLogs not implemented")
+}
+func (UnimplementedCliServiceServer) ListBuilds(context.Context, *ListBuildsRequest) (*ListBuildsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuilds not implemented")
+}
+func (UnimplementedCliServiceServer) GetBuild(context.Context, *GetBuildRequest) (*GetBuildReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuild not implemented")
+}
+func (UnimplementedCliServiceServer) CancelBuild(context.Context, *CancelBuildRequest) (*CancelBuildReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBuild not implemented")
+}
 func (UnimplementedCliServiceServer) GetServiceUploadUrl(context.Context, *GetServiceUploadUrlRequest) (*GetServiceUploadUrlResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServiceUploadUrl not implemented")
 }
@@ -218,104 +628,506 @@ func _CliService_Init_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CliService_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeployRequest)
+func _CliService_ListEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvironmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CliServiceServer).Deploy(ctx, in)
+		return srv.(CliServiceServer).ListEnvironments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CliService/Deploy",
+		FullMethod: "/CliService/ListEnvironments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CliServiceServer).Deploy(ctx, req.(*DeployRequest))
+		return srv.(CliServiceServer).ListEnvironments(ctx, req.(*ListEnvironmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CliService_ListEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEnvRequest)
+func _CliService_DescribeEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeEnvironmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CliServiceServer).ListEnv(ctx, in)
+		return srv.(CliServiceServer).DescribeEnvironment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CliService/ListEnv",
+		FullMethod: "/CliService/DescribeEnvironment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CliServiceServer).ListEnv(ctx, req.(*ListEnvRequest))
+		return srv.(CliServiceServer).DescribeEnvironment(ctx, req.(*DescribeEnvironmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CliService_SetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetEnvRequest)
+func _CliService_SetEnvironmentTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnvironmentTierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CliServiceServer).SetEnv(ctx, in)
+		return srv.(CliServiceServer).SetEnvironmentTier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CliService/SetEnv",
+		FullMethod: "/CliService/SetEnvironmentTier",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CliServiceServer).SetEnv(ctx, req.(*SetEnvRequest))
+		return srv.(CliServiceServer).SetEnvironmentTier(ctx, req.(*SetEnvironmentTierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CliService_RemoveEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEnvRequest)
+func _CliService_CloneEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneEnvironmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CliServiceServer).RemoveEnv(ctx, in)
+		return srv.(CliServiceServer).CloneEnvironment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CliService/RemoveEnv",
+		FullMethod: "/CliService/CloneEnvironment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CliServiceServer).RemoveEnv(ctx, req.(*RemoveEnvRequest))
+		return srv.(CliServiceServer).CloneEnvironment(ctx, req.(*CloneEnvironmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CliService_DockerLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DockerLoginRequest)
+func _CliService_ExtendEnvironmentTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendEnvironmentTTLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CliServiceServer).DockerLogin(ctx, in)
+		return srv.(CliServiceServer).ExtendEnvironmentTTL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CliService/DockerLogin",
+		FullMethod: "/CliService/ExtendEnvironmentTTL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CliServiceServer).DockerLogin(ctx, req.(*DockerLoginRequest))
+		return srv.(CliServiceServer).ExtendEnvironmentTTL(ctx, req.(*ExtendEnvironmentTTLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CliService_Up_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(CliServiceServer).Up(&cliServiceUpServer{stream})
+func _CliService_DiffEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffEnvironmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).DiffEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/DiffEnvironments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).DiffEnvironments(ctx, req.(*DiffEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type CliService_UpServer interface {
-	Send(*UpResponse) error
-	Recv() (*UpRequest, error)
-	grpc.ServerStream
+func _CliService_DeleteEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).DeleteEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/DeleteEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).DeleteEnvironment(ctx, req.(*DeleteEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).Apply(ctx, req.(*ApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).Deploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/Deploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).Deploy(ctx, req.(*DeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_DeployStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeployRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CliServiceServer).DeployStream(m, &cliServiceDeployStreamServer{stream})
+}
+
+type CliService_DeployStreamServer interface {
+	Send(*DeployEvent) error
+	grpc.ServerStream
+}
+
+type cliServiceDeployStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *cliServiceDeployStreamServer) Send(m *DeployEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CliService_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/Promote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).Promote(ctx, req.(*PromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).Rollback(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_SetTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).SetTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/SetTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).SetTraffic(ctx, req.(*SetTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_Rollout_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RolloutRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CliServiceServer).Rollout(m, &cliServiceRolloutServer{stream})
+}
+
+type CliService_RolloutServer interface {
+	Send(*RolloutUpdate) error
+	grpc.ServerStream
+}
+
+type cliServiceRolloutServer struct {
+	grpc.ServerStream
+}
+
+func (x *cliServiceRolloutServer) Send(m *RolloutUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CliService_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/ListServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).ListServices(ctx, req.(*ListServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_GetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).GetService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/GetService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).GetService(ctx, req.(*GetServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).DeleteService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/DeleteService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).DeleteService(ctx, req.(*DeleteServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_ListEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).ListEnv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/ListEnv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).ListEnv(ctx, req.(*ListEnvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_SetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).SetEnv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/SetEnv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).SetEnv(ctx, req.(*SetEnvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_RemoveEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEnvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).RemoveEnv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/RemoveEnv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).RemoveEnv(ctx, req.(*RemoveEnvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_ImportEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEnvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).ImportEnv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/ImportEnv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).ImportEnv(ctx, req.(*ImportEnvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_ExportEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEnvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).ExportEnv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/ExportEnv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).ExportEnv(ctx, req.(*ExportEnvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_ListEnvRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).ListEnvRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/ListEnvRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).ListEnvRevisions(ctx, req.(*ListEnvRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_RestoreEnvRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEnvRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).RestoreEnvRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/RestoreEnvRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).RestoreEnvRevision(ctx, req.(*RestoreEnvRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_DockerLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DockerLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).DockerLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/DockerLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).DockerLogin(ctx, req.(*DockerLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_Up_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CliServiceServer).Up(&cliServiceUpServer{stream})
+}
+
+type CliService_UpServer interface {
+	Send(*UpResponse) error
+	Recv() (*UpRequest, error)
+	grpc.ServerStream
 }
 
 type cliServiceUpServer struct {
@@ -334,6 +1146,78 @@ func (x *cliServiceUpServer) Recv() (*UpRequest, error) {
 	return m, nil
 }
 
+func _CliService_GetBuildLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuildLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).GetBuildLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/GetBuildLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).GetBuildLogs(ctx, req.(*GetBuildLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_ListBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBuildsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).ListBuilds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/ListBuilds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).ListBuilds(ctx, req.(*ListBuildsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_GetBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).GetBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/GetBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).GetBuild(ctx, req.(*GetBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliService_CancelBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliServiceServer).CancelBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CliService/CancelBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliServiceServer).CancelBuild(ctx, req.(*CancelBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CliService_GetServiceUploadUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetServiceUploadUrlRequest)
 	if err := dec(in); err != nil {
@@ -381,10 +1265,74 @@ var CliService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Init",
 			Handler:    _CliService_Init_Handler,
 		},
+		{
+			MethodName: "ListEnvironments",
+			Handler:    _CliService_ListEnvironments_Handler,
+		},
+		{
+			MethodName: "DescribeEnvironment",
+			Handler:    _CliService_DescribeEnvironment_Handler,
+		},
+		{
+			MethodName: "SetEnvironmentTier",
+			Handler:    _CliService_SetEnvironmentTier_Handler,
+		},
+		{
+			MethodName: "CloneEnvironment",
+			Handler:    _CliService_CloneEnvironment_Handler,
+		},
+		{
+			MethodName: "ExtendEnvironmentTTL",
+			Handler:    _CliService_ExtendEnvironmentTTL_Handler,
+		},
+		{
+			MethodName: "DiffEnvironments",
+			Handler:    _CliService_DiffEnvironments_Handler,
+		},
+		{
+			MethodName: "DeleteEnvironment",
+			Handler:    _CliService_DeleteEnvironment_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _CliService_Apply_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _CliService_Export_Handler,
+		},
 		{
 			MethodName: "Deploy",
 			Handler:    _CliService_Deploy_Handler,
 		},
+		{
+			MethodName: "Promote",
+			Handler:    _CliService_Promote_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _CliService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _CliService_Rollback_Handler,
+		},
+		{
+			MethodName: "SetTraffic",
+			Handler:    _CliService_SetTraffic_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _CliService_ListServices_Handler,
+		},
+		{
+			MethodName: "GetService",
+			Handler:    _CliService_GetService_Handler,
+		},
+		{
+			MethodName: "DeleteService",
+			Handler:    _CliService_DeleteService_Handler,
+		},
 		{
 			MethodName: "ListEnv",
 			Handler:    _CliService_ListEnv_Handler,
@@ -397,10 +1345,42 @@ var CliService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveEnv",
 			Handler:    _CliService_RemoveEnv_Handler,
 		},
+		{
+			MethodName: "ImportEnv",
+			Handler:    _CliService_ImportEnv_Handler,
+		},
+		{
+			MethodName: "ExportEnv",
+			Handler:    _CliService_ExportEnv_Handler,
+		},
+		{
+			MethodName: "ListEnvRevisions",
+			Handler:    _CliService_ListEnvRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreEnvRevision",
+			Handler:    _CliService_RestoreEnvRevision_Handler,
+		},
 		{
 			MethodName: "DockerLogin",
 			Handler:    _CliService_DockerLogin_Handler,
 		},
+		{
+			MethodName: "GetBuildLogs",
+			Handler:    _CliService_GetBuildLogs_Handler,
+		},
+		{
+			MethodName: "ListBuilds",
+			Handler:    _CliService_ListBuilds_Handler,
+		},
+		{
+			MethodName: "GetBuild",
+			Handler:    _CliService_GetBuild_Handler,
+		},
+		{
+			MethodName: "CancelBuild",
+			Handler:    _CliService_CancelBuild_Handler,
+		},
 		{
 			MethodName: "GetServiceUploadUrl",
 			Handler:    _CliService_GetServiceUploadUrl_Handler,
@@ -411,6 +1391,16 @@ var CliService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DeployStream",
+			Handler:       _CliService_DeployStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Rollout",
+			Handler:       _CliService_Rollout_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Up",
 			Handler:       _CliService_Up_Handler,
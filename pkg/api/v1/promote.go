@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// knative names the container running the image of a service like this
+	knativeUserContainer = "user-container"
+	// container runtimes might prefix image ids with this
+	dockerPullablePrefix = "docker-pullable://"
+)
+
+// Promote deploys the image a service runs in one environment to another environment.
+// Tags are mutable, so the image is promoted by the digest the source service actually runs.
+// The deploy itself goes through the same path as Deploy.
+func (s *CliServer) Promote(ctx context.Context, req *pb.PromoteRequest) (*pb.PromoteReply, error) {
+	sourceNamespaceName, err := s.environmentNamespace(ctx, req.SourceEnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	namespaceName, err := s.environmentNamespace(ctx, req.TargetEnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	serviceName := req.TargetServiceName
+	if serviceName == "" {
+		serviceName = req.ServiceName
+	}
+	logger := s.logger.WithValues("sourceNamespaceName", sourceNamespaceName, "namespaceName", namespaceName, "serviceName", serviceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("promote service", "sourceServiceName", req.ServiceName, "copyEnv", req.CopyEnv)
+
+	source, err := s.haikuClient.ServingV1alpha1().Services(sourceNamespaceName).Get(ctx, req.ServiceName, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("source service doesn't exist")
+		return nil, fmt.Errorf("%w: service %s in environment %s", ErrNotFound, req.ServiceName, req.SourceEnvironmentName)
+	} else if err != nil {
+		logger.Error(err, "failed to get source service")
+		return nil, err
+	}
+
+	image, err := s.resolveImageDigest(ctx, source)
+	if err != nil {
+		logger.Error(err, "failed to resolve image digest")
+		return nil, err
+	}
+
+	if req.CopyEnv {
+		err = s.promoteEnv(ctx, sourceNamespaceName, req.ServiceName, namespaceName, serviceName)
+		if err != nil {
+			logger.Error(err, "failed to copy env")
+			return nil, err
+		}
+	}
+
+	service, revision, err := s.deploy(ctx, namespaceName, &pb.DeployRequest{
+		Image:           image,
+		EnvironmentName: req.TargetEnvironmentName,
+		ServiceName:     serviceName,
+	})
+	if err != nil {
+		logger.Error(err, "failed to deploy service")
+		return nil, err
+	}
+
+	ready, err := s.waitForService(ctx, service, logger)
+	if err != nil {
+		logger.Error(err, "failed to watch service")
+		return nil, err
+	}
+
+	return &pb.PromoteReply{
+		ID:       string(service.UID),
+		URL:      ready.Status.URL,
+		Revision: revision,
+		Image:    image,
+	}, nil
+}
+
+// resolveImageDigest returns the image of a service by digest.
+// Knative resolves tags when it creates a revision, the result shows up in the status of the pods.
+// Only pods of the revision the service spec asks for are looked at, and only once that revision is ready,
+// so the digest is the one of the image in the spec and not of whatever ran before.
+func (s *CliServer) resolveImageDigest(ctx context.Context, service *v1alpha1.Service) (string, error) {
+	image := service.Spec.Image
+	if strings.Contains(image, "@sha256:") {
+		return image, nil
+	}
+
+	revisionName := service.Status.LatestReadyRevisionName
+	if revisionName == "" || revisionName != service.Spec.RevisionName {
+		return "", status.Error(codes.FailedPrecondition, fmt.Sprintf("service %s isn't ready with image %s yet", service.Name, image))
+	}
+
+	pods, err := s.k8sClient.CoreV1().Pods(service.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{labelKnativeRevision: revisionName}).String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[j].CreationTimestamp.Before(&pods.Items[i].CreationTimestamp)
+	})
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Name != knativeUserContainer || !cs.Ready {
+				continue
+			}
+			if digest := imageDigest(cs); digest != "" {
+				return digest, nil
+			}
+		}
+	}
+	return "", status.Error(codes.FailedPrecondition, fmt.Sprintf("service %s has no ready pod to take the image digest of %s from", service.Name, image))
+}
+
+// promoteEnv copies the plain env of a service over the env of another one.
+// Keys that are secret in the target stay untouched, those are meant to differ between environments.
+// Keys the source doesn't have are left alone as well.
+func (s *CliServer) promoteEnv(ctx context.Context, sourceNamespaceName string, sourceServiceName string, namespaceName string, serviceName string) error {
+	sourceEnv, _, err := s.getEnv(ctx, sourceNamespaceName, sourceServiceName)
+	if err != nil || len(sourceEnv) == 0 {
+		return err
+	}
+
+	// no rollout of its own, the deploy that follows picks the env up
+	_, err = s.changeEnv(ctx, namespaceName, serviceName, func(env map[string]string, secretEnv map[string]string) error {
+		for key, value := range sourceEnv {
+			if _, ok := secretEnv[key]; !ok {
+				env[key] = value
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// imageDigest turns the image id of a container into something that can be pulled.
+func imageDigest(cs corev1.ContainerStatus) string {
+	imageID := strings.TrimPrefix(cs.ImageID, dockerPullablePrefix)
+	if !strings.Contains(imageID, "@sha256:") {
+		return ""
+	}
+	return imageID
+}
@@ -0,0 +1,332 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+// that many (deploy) revisions are kept around per service
+const revisionHistoryLimit = 50
+
+// keys of the data in a revision configmap
+const (
+	revisionDataImage       = "image"
+	revisionDataSpec        = "spec"
+	revisionDataConfig      = "config"
+	revisionDataEnvRevision = "envRevision"
+)
+
+// Every deploy is recorded as (deploy) revision.
+// A revision is a k8s configmap that holds the spec and config of the haiku service as it was deployed
+// and a pointer to the env revision that was current at the time.
+func (s *CliServer) ListRevisions(ctx context.Context, req *pb.ListRevisionsRequest) (*pb.ListRevisionsReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("list revisions")
+	configMaps, err := s.listRevisions(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to list revisions")
+		return nil, err
+	}
+
+	revisions := make([]*pb.DeployRevision, len(configMaps))
+	for idx := range configMaps {
+		revisions[idx] = toDeployRevision(&configMaps[idx])
+	}
+
+	return &pb.ListRevisionsReply{
+		Revisions: revisions,
+	}, nil
+}
+
+// Rollback redeploys a previous revision including the env it was deployed with.
+// The env and the image go out together as a single new revision.
+func (s *CliServer) Rollback(ctx context.Context, req *pb.RollbackRequest) (*pb.RollbackReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("rollback service", "revision", req.Revision)
+	cm, err := s.getRevision(ctx, namespaceName, req.ServiceName, req.Revision)
+	if err != nil && IsNotFound(err) {
+		logger.Info("revision doesn't exist")
+		return nil, err
+	} else if err != nil {
+		logger.Error(err, "failed to get revision")
+		return nil, err
+	}
+
+	var spec v1alpha1.ServiceSpec
+	err = json.Unmarshal([]byte(cm.Data[revisionDataSpec]), &spec)
+	if err != nil {
+		logger.Error(err, "failed to read revision spec")
+		return nil, err
+	}
+
+	config := map[string]string{}
+	err = json.Unmarshal([]byte(cm.Data[revisionDataConfig]), &config)
+	if err != nil {
+		logger.Error(err, "failed to read revision config")
+		return nil, err
+	}
+
+	previousEnv, previousSecretEnv, err := s.rollbackEnv(ctx, namespaceName, req.ServiceName, cm)
+	if err != nil {
+		logger.Error(err, "failed to roll back env")
+		return nil, err
+	}
+
+	service, revision, err := s.applyService(ctx, namespaceName, req.ServiceName, func(svc *v1alpha1.Service) {
+		svc.Spec = spec
+		for key := range configAnnotations(&svc.ObjectMeta) {
+			delete(svc.Annotations, key)
+		}
+		setAnnotations(&svc.ObjectMeta, config)
+		// the rolled back revision gets all the traffic
+		svc.Spec.Traffic = nil
+	})
+	if err != nil {
+		logger.Error(err, "failed to apply service")
+		if previousEnv != nil {
+			// the running revision keeps its env, the live env goes back to match it
+			restoreCtx, cancel := context.WithTimeout(detachedContext{ctx}, envRestoreTimeout)
+			_, restoreErr := s.replaceEnv(restoreCtx, namespaceName, req.ServiceName, previousEnv, previousSecretEnv)
+			cancel()
+			if restoreErr != nil {
+				logger.Error(restoreErr, "failed to restore env")
+			}
+		}
+		return nil, err
+	}
+
+	ready, err := s.waitForService(ctx, service, logger)
+	if err != nil {
+		logger.Error(err, "failed to watch service")
+		return nil, err
+	}
+
+	return &pb.RollbackReply{
+		ID:       string(service.UID),
+		URL:      ready.Status.URL,
+		Revision: revision,
+	}, nil
+}
+
+// rollbackEnv restores the env revision a revision was deployed with.
+// Nothing happens if the env didn't change since.
+// The env isn't rolled out on its own, the redeploy of the revision picks it up.
+// It returns the plain and secret env that were replaced, nil if nothing was.
+func (s *CliServer) rollbackEnv(ctx context.Context, namespaceName string, serviceName string, cm *corev1.ConfigMap) (map[string]string, map[string]string, error) {
+	envRevision, err := strconv.ParseInt(cm.Data[revisionDataEnvRevision], 10, 64)
+	if err != nil || envRevision == 0 {
+		// the env was never touched through haiku when this was deployed
+		return nil, nil, nil
+	}
+
+	latestEnvRevision, err := s.latestEnvRevision(ctx, namespaceName, serviceName)
+	if err != nil || latestEnvRevision == envRevision {
+		return nil, nil, err
+	}
+
+	snapshot, err := s.k8sClient.CoreV1().Secrets(namespaceName).Get(ctx, envRevisionName(serviceName, envRevision), metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("%w: env revision %d was pruned", ErrNotFound, envRevision)
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	env, secretEnv, err := s.getEnv(ctx, namespaceName, serviceName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = s.restoreEnvSnapshot(ctx, namespaceName, serviceName, snapshot.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return env, secretEnv, nil
+}
+
+// reserveRevision hands out the number of the next revision of a service.
+// Revision numbers are handed out by trying to create the next one and retrying if somebody else was faster.
+// The revision is filled in by recordRevision once the service is written.
+// envRevision is the env revision the service is deployed with.
+func (s *CliServer) reserveRevision(ctx context.Context, namespaceName string, serviceName string, envRevision int64) (int64, error) {
+	var revision int64
+	err := retry.OnError(retry.DefaultRetry, errors.IsAlreadyExists, func() error {
+		existing, err := s.listRevisions(ctx, namespaceName, serviceName)
+		if err != nil {
+			return err
+		}
+
+		revision = 1
+		if len(existing) > 0 {
+			revision = revisionNumber(&existing[len(existing)-1].ObjectMeta) + 1
+		}
+
+		cmLabels := serviceLabels(serviceName, componentRevision)
+		cmLabels[labelRevision] = strconv.FormatInt(revision, 10)
+		_, err = s.k8sClient.CoreV1().ConfigMaps(namespaceName).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      revisionName(serviceName, revision),
+				Labels:    cmLabels,
+				Annotations: map[string]string{
+					annotationDeployedBy: callerFromContext(ctx),
+					annotationRequestID:  requestid.FromContext(ctx),
+				},
+			},
+			Data: map[string]string{
+				revisionDataEnvRevision: strconv.FormatInt(envRevision, 10),
+			},
+		}, metav1.CreateOptions{})
+		return err
+	})
+	return revision, err
+}
+
+// recordRevision snapshots a freshly deployed service into the revision reserved for it.
+func (s *CliServer) recordRevision(ctx context.Context, service *v1alpha1.Service, revision int64) error {
+	spec, err := json.Marshal(service.Spec)
+	if err != nil {
+		return err
+	}
+
+	config, err := json.Marshal(configAnnotations(&service.ObjectMeta))
+	if err != nil {
+		return err
+	}
+
+	configMaps := s.k8sClient.CoreV1().ConfigMaps(service.Namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := configMaps.Get(ctx, revisionName(service.Name, revision), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[revisionDataImage] = service.Spec.Image
+		cm.Data[revisionDataSpec] = string(spec)
+		cm.Data[revisionDataConfig] = string(config)
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// pruneRevisions deletes the revisions that have fallen out of the history.
+// Pruning is best effort, whatever is left is pruned next time.
+func (s *CliServer) pruneRevisions(ctx context.Context, namespaceName string, serviceName string, revision int64) {
+	existing, err := s.listRevisions(ctx, namespaceName, serviceName)
+	if err != nil {
+		s.logger.Error(err, "failed to list revisions", "namespaceName", namespaceName, "serviceName", serviceName)
+		return
+	}
+
+	for idx := range existing {
+		if revisionNumber(&existing[idx].ObjectMeta) > revision-revisionHistoryLimit {
+			break
+		}
+		err = s.k8sClient.CoreV1().ConfigMaps(namespaceName).Delete(ctx, existing[idx].Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			s.logger.Error(err, "failed to prune revision", "namespaceName", namespaceName, "name", existing[idx].Name)
+		}
+	}
+}
+
+// releaseRevision gives back a reserved revision if the service couldn't be written.
+func (s *CliServer) releaseRevision(ctx context.Context, namespaceName string, serviceName string, revision int64) error {
+	err := s.k8sClient.CoreV1().ConfigMaps(namespaceName).Delete(ctx, revisionName(serviceName, revision), metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *CliServer) getRevision(ctx context.Context, namespaceName string, serviceName string, revision int64) (*corev1.ConfigMap, error) {
+	cm, err := s.k8sClient.CoreV1().ConfigMaps(namespaceName).Get(ctx, revisionName(serviceName, revision), metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: revision %d", ErrNotFound, revision)
+	}
+	return cm, err
+}
+
+// latestRevision returns the number of the most recent revision of a service.
+// It's zero if the service was never deployed.
+func (s *CliServer) latestRevision(ctx context.Context, namespaceName string, serviceName string) (int64, error) {
+	revisions, err := s.listRevisions(ctx, namespaceName, serviceName)
+	if err != nil || len(revisions) == 0 {
+		return 0, err
+	}
+	return revisionNumber(&revisions[len(revisions)-1].ObjectMeta), nil
+}
+
+// listRevisions returns all revisions of a service ordered by revision number.
+func (s *CliServer) listRevisions(ctx context.Context, namespaceName string, serviceName string) ([]corev1.ConfigMap, error) {
+	list, err := s.k8sClient.CoreV1().ConfigMaps(namespaceName).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(serviceLabels(serviceName, componentRevision)).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := list.Items
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisionNumber(&revisions[i].ObjectMeta) < revisionNumber(&revisions[j].ObjectMeta)
+	})
+	return revisions, nil
+}
+
+func toDeployRevision(cm *corev1.ConfigMap) *pb.DeployRevision {
+	envRevision, _ := strconv.ParseInt(cm.Data[revisionDataEnvRevision], 10, 64)
+	return &pb.DeployRevision{
+		Revision:    revisionNumber(&cm.ObjectMeta),
+		Image:       cm.Data[revisionDataImage],
+		EnvRevision: envRevision,
+		DeployedBy:  cm.Annotations[annotationDeployedBy],
+		RequestID:   cm.Annotations[annotationRequestID],
+		Timestamp:   cm.CreationTimestamp.Unix(),
+	}
+}
+
+func revisionName(serviceName string, revision int64) string {
+	return fmt.Sprintf("haiku-rev-%s-%d", serviceName, revision)
+}
+
+// knativeRevisionName is the name the haiku service gives a revision.
+// The operator insists on the service name coming first.
+func knativeRevisionName(serviceName string, revision int64) string {
+	return fmt.Sprintf("%s-r%d", serviceName, revision)
+}
+
+// revisionFromKnativeName is the reverse of knativeRevisionName.
+// It's zero for revisions that weren't named by haiku-api.
+func revisionFromKnativeName(serviceName string, name string) int64 {
+	prefix := serviceName + "-r"
+	if !strings.HasPrefix(name, prefix) {
+		return 0
+	}
+	revision, err := strconv.ParseInt(strings.TrimPrefix(name, prefix), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
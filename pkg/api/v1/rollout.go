@@ -0,0 +1,305 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+)
+
+const (
+	// the new revision is reachable under this tag while it doesn't get all the traffic
+	candidateTag = "candidate"
+
+	defaultStepInterval      = 30 * time.Second
+	defaultHealthCheckSample = 3
+	healthCheckTimeout       = 10 * time.Second
+	// how long rolling back traffic may take once a rollout is aborted
+	abortTimeout = 30 * time.Second
+)
+
+var defaultRolloutSteps = []int64{10, 50, 100}
+
+// Rollout deploys a new revision without traffic and shifts traffic to it step by step.
+// The new revision is health checked through its tagged URL before it gets any traffic and between steps.
+// If a check fails, all traffic goes back to the previous revision.
+func (s *CliServer) Rollout(req *pb.RolloutRequest, stream pb.CliService_RolloutServer) error {
+	ctx := stream.Context()
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("rollout service", "image", req.Image)
+	steps, err := rolloutSteps(req.Steps)
+	if err != nil {
+		return err
+	}
+
+	interval := defaultStepInterval
+	if req.StepIntervalSeconds > 0 {
+		interval = time.Duration(req.StepIntervalSeconds) * time.Second
+	}
+
+	previousRevision, err := s.latestRevision(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to get previous revision")
+		return err
+	}
+
+	// there's nothing to roll back to, so this is a plain deploy
+	if previousRevision == 0 {
+		service, revision, err := s.applyService(ctx, namespaceName, req.ServiceName, func(svc *v1alpha1.Service) {
+			svc.Spec.Image = req.Image
+			svc.Spec.Traffic = nil
+		})
+		if err != nil {
+			logger.Error(err, "failed to apply service")
+			return err
+		}
+
+		service, err = s.waitForService(ctx, service, logger)
+		if err != nil {
+			logger.Error(err, "failed to watch service")
+			return err
+		}
+
+		return stream.Send(&pb.RolloutUpdate{
+			Phase:    pb.RolloutUpdate_PROMOTED,
+			Revision: revision,
+			Percent:  100,
+			Message:  "first revision of the service gets all traffic",
+			URL:      service.Status.URL,
+		})
+	}
+
+	// once the new revision gets all traffic, it doesn't need a tag anymore
+	candidateTraffic := func(percent int64) ([]trafficTarget, error) {
+		if percent == 100 {
+			return defaultTraffic, nil
+		}
+		return s.resolveTraffic(ctx, namespaceName, req.ServiceName, []*pb.TrafficTarget{
+			{Revision: previousRevision, Percent: 100 - percent},
+			{LatestRevision: true, Percent: percent, Tag: candidateTag},
+		})
+	}
+
+	targets, err := candidateTraffic(0)
+	if err != nil {
+		return err
+	}
+
+	service, revision, err := s.applyService(ctx, namespaceName, req.ServiceName, func(svc *v1alpha1.Service) {
+		svc.Spec.Image = req.Image
+		setTraffic(svc, targets)
+	})
+	if err != nil {
+		logger.Error(err, "failed to apply service")
+		return err
+	}
+	// from here on the previous revision gets all traffic back (and the candidate loses its tag)
+	// whenever the rollout doesn't finish, a candidate that never gets ready and a client that went away included
+	abort := func(percent int64, cause error) error {
+		return s.abortRollout(ctx, stream, namespaceName, req, previousRevision, revision, percent, cause, logger)
+	}
+
+	service, err = s.waitForService(ctx, service, logger)
+	if err != nil {
+		logger.Error(err, "failed to watch service")
+		return abort(0, err)
+	}
+
+	serviceURL := service.Status.URL
+	candidateURL := taggedURL(service, candidateTag)
+	if candidateURL == "" {
+		return abort(0, fmt.Errorf("no URL for the %s tag of service %s", candidateTag, service.Name))
+	}
+
+	err = stream.Send(&pb.RolloutUpdate{
+		Phase:    pb.RolloutUpdate_DEPLOYED,
+		Revision: revision,
+		Message:  fmt.Sprintf("revision %d is deployed without traffic", revision),
+		URL:      candidateURL,
+	})
+	if err != nil {
+		return abort(0, err)
+	}
+
+	// the new revision has to pass before it gets any traffic at all
+	err = checkHealth(ctx, candidateURL, req.HealthCheck)
+	if err != nil {
+		logger.Info("health check failed", "percent", 0, "error", err.Error())
+		return abort(0, err)
+	}
+
+	err = stream.Send(&pb.RolloutUpdate{
+		Phase:    pb.RolloutUpdate_CHECKED,
+		Revision: revision,
+		Message:  "health check passed",
+		URL:      candidateURL,
+	})
+	if err != nil {
+		return abort(0, err)
+	}
+
+	for _, percent := range steps {
+		targets, err := candidateTraffic(percent)
+		if err != nil {
+			return abort(percent, err)
+		}
+
+		_, err = s.updateService(ctx, namespaceName, req.ServiceName, func(svc *v1alpha1.Service) {
+			setTraffic(svc, targets)
+		})
+		if err != nil {
+			logger.Error(err, "failed to shift traffic")
+			return abort(percent, err)
+		}
+
+		err = stream.Send(&pb.RolloutUpdate{
+			Phase:    pb.RolloutUpdate_SHIFTED,
+			Revision: revision,
+			Percent:  percent,
+			Message:  fmt.Sprintf("revision %d gets %d%% of the traffic", revision, percent),
+			URL:      candidateURL,
+		})
+		if err != nil {
+			return abort(percent, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return abort(percent, ctx.Err())
+		case <-time.After(interval):
+		}
+
+		checkURL := candidateURL
+		if percent == 100 {
+			checkURL = serviceURL
+		}
+		err = checkHealth(ctx, checkURL, req.HealthCheck)
+		if err != nil {
+			logger.Info("health check failed", "percent", percent, "error", err.Error())
+			return abort(percent, err)
+		}
+
+		err = stream.Send(&pb.RolloutUpdate{
+			Phase:    pb.RolloutUpdate_CHECKED,
+			Revision: revision,
+			Percent:  percent,
+			Message:  "health check passed",
+			URL:      checkURL,
+		})
+		if err != nil {
+			return abort(percent, err)
+		}
+	}
+
+	// the new revision made it, a client that went away only misses the news
+	return stream.Send(&pb.RolloutUpdate{
+		Phase:    pb.RolloutUpdate_PROMOTED,
+		Revision: revision,
+		Percent:  100,
+		Message:  fmt.Sprintf("revision %d gets all traffic", revision),
+		URL:      serviceURL,
+	})
+}
+
+// abortRollout sends all traffic back to the previous revision and reports why.
+// The request might be over already (that's one reason to abort), so the traffic is
+// shifted back on a context of its own that only keeps the values of the request.
+func (s *CliServer) abortRollout(ctx context.Context, stream pb.CliService_RolloutServer, namespaceName string, req *pb.RolloutRequest, previousRevision int64, revision int64, percent int64, cause error, logger logr.Logger) error {
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, abortTimeout)
+	defer cancel()
+
+	targets, err := s.resolveTraffic(ctx, namespaceName, req.ServiceName, []*pb.TrafficTarget{
+		{Revision: previousRevision, Percent: 100},
+	})
+	if err != nil {
+		logger.Error(err, "failed to roll back traffic")
+		return err
+	}
+
+	_, err = s.updateService(ctx, namespaceName, req.ServiceName, func(svc *v1alpha1.Service) {
+		setTraffic(svc, targets)
+	})
+	if err != nil {
+		logger.Error(err, "failed to roll back traffic")
+		return err
+	}
+
+	// the client might be gone already, nothing we can do about that
+	_ = stream.Send(&pb.RolloutUpdate{
+		Phase:    pb.RolloutUpdate_ROLLED_BACK,
+		Revision: revision,
+		Percent:  percent,
+		Message:  fmt.Sprintf("all traffic went back to revision %d: %s", previousRevision, cause.Error()),
+	})
+	return fmt.Errorf("rollout of revision %d failed: %w", revision, cause)
+}
+
+func rolloutSteps(steps []int64) ([]int64, error) {
+	if len(steps) == 0 {
+		return defaultRolloutSteps, nil
+	}
+
+	var previous int64
+	for _, step := range steps {
+		if step <= previous || step > 100 {
+			return nil, fmt.Errorf("%w: steps need to be increasing percentages", ErrInvalidArgument)
+		}
+		previous = step
+	}
+	if previous != 100 {
+		steps = append(steps, 100)
+	}
+	return steps, nil
+}
+
+// checkHealth sends a couple of requests to a URL and fails on the first unexpected status or slow response.
+func checkHealth(ctx context.Context, baseURL string, hc *pb.HealthCheck) error {
+	if hc == nil {
+		hc = &pb.HealthCheck{}
+	}
+
+	samples := int(hc.Samples)
+	if samples <= 0 {
+		samples = defaultHealthCheckSample
+	}
+
+	target := strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(hc.Path, "/")
+	client := &http.Client{
+		Timeout: healthCheckTimeout,
+	}
+	for idx := 0; idx < samples; idx++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		latency := time.Since(start)
+
+		if hc.ExpectedStatus != 0 && resp.StatusCode != int(hc.ExpectedStatus) {
+			return fmt.Errorf("%s returned %d instead of %d", target, resp.StatusCode, hc.ExpectedStatus)
+		} else if hc.ExpectedStatus == 0 && resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("%s returned %d", target, resp.StatusCode)
+		}
+
+		if hc.MaxLatencyMilliseconds > 0 && latency > time.Duration(hc.MaxLatencyMilliseconds)*time.Millisecond {
+			return fmt.Errorf("%s took %s which is more than %dms", target, latency, hc.MaxLatencyMilliseconds)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ListServices returns all haiku services of an environment ordered by name.
+func (s *CliServer) ListServices(ctx context.Context, req *pb.ListServicesRequest) (*pb.ListServicesReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("list services")
+	services, err := s.listServices(ctx, namespaceName)
+	if err != nil {
+		logger.Error(err, "failed to list services")
+		return nil, err
+	}
+
+	return &pb.ListServicesReply{
+		Services: services,
+	}, nil
+}
+
+func (s *CliServer) GetService(ctx context.Context, req *pb.GetServiceRequest) (*pb.GetServiceReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("get service")
+	service, err := s.haikuClient.ServingV1alpha1().Services(namespaceName).Get(ctx, req.ServiceName, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("service doesn't exist")
+		return nil, ErrNotFound
+	} else if err != nil {
+		logger.Error(err, "failed to get service")
+		return nil, err
+	}
+
+	revision, err := s.latestRevision(ctx, namespaceName, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to get latest revision")
+		return nil, err
+	}
+
+	return &pb.GetServiceReply{
+		Service: toServiceInfo(service, revision),
+	}, nil
+}
+
+// DeleteService removes a haiku service and everything haiku-api keeps on its behalf,
+// that is its env, env revisions and revisions.
+// Deleting a service that doesn't exist (anymore) still cleans up what's left of it.
+func (s *CliServer) DeleteService(ctx context.Context, req *pb.DeleteServiceRequest) (*pb.DeleteServiceReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("delete service")
+	err = s.deleteService(ctx, namespaceName, req.ServiceName, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteServiceReply{
+		Success: true,
+	}, nil
+}
+
+// deleteService deletes a haiku service and everything labelled as belonging to it.
+func (s *CliServer) deleteService(ctx context.Context, namespaceName string, serviceName string, logger logr.Logger) error {
+	// builds that are still going on would bring the service back
+	err := s.cancelServiceBuilds(ctx, namespaceName, serviceName, logger)
+	if err != nil {
+		return err
+	}
+
+	err = s.haikuClient.ServingV1alpha1().Services(namespaceName).Delete(ctx, serviceName, metav1.DeleteOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("service doesn't exist")
+	} else if err != nil {
+		logger.Error(err, "failed to delete service")
+		return err
+	}
+
+	selector := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			labelManagedBy: managedByHaikuAPI,
+			labelService:   serviceName,
+		}).String(),
+	}
+
+	err = s.k8sClient.CoreV1().ConfigMaps(namespaceName).DeleteCollection(ctx, metav1.DeleteOptions{}, selector)
+	if err != nil {
+		logger.Error(err, "failed to delete configmaps")
+		return err
+	}
+
+	err = s.k8sClient.CoreV1().Secrets(namespaceName).DeleteCollection(ctx, metav1.DeleteOptions{}, selector)
+	if err != nil {
+		logger.Error(err, "failed to delete secrets")
+		return err
+	}
+	return nil
+}
+
+func (s *CliServer) listServices(ctx context.Context, namespaceName string) ([]*pb.ServiceInfo, error) {
+	list, err := s.haikuClient.ServingV1alpha1().Services(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.latestRevisions(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]*pb.ServiceInfo, len(list.Items))
+	for idx := range list.Items {
+		services[idx] = toServiceInfo(&list.Items[idx], revisions[list.Items[idx].Name])
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+	return services, nil
+}
+
+// latestRevisions returns the number of the most recent revision of every service in a namespace.
+func (s *CliServer) latestRevisions(ctx context.Context, namespaceName string) (map[string]int64, error) {
+	list, err := s.k8sClient.CoreV1().ConfigMaps(namespaceName).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			labelManagedBy: managedByHaikuAPI,
+			labelComponent: componentRevision,
+		}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := map[string]int64{}
+	for idx := range list.Items {
+		serviceName := list.Items[idx].Labels[labelService]
+		if revision := revisionNumber(&list.Items[idx].ObjectMeta); revision > revisions[serviceName] {
+			revisions[serviceName] = revision
+		}
+	}
+	return revisions, nil
+}
+
+// toServiceInfo describes a haiku service.
+// Ready means the revision the service was last written with is serving.
+func toServiceInfo(service *v1alpha1.Service, revision int64) *pb.ServiceInfo {
+	ready, _ := revisionReady(service, service)
+	return &pb.ServiceInfo{
+		ID:         string(service.UID),
+		Name:       service.Name,
+		Image:      service.Spec.Image,
+		URL:        service.Status.URL,
+		Revision:   revision,
+		Ready:      ready,
+		CreatedAt:  service.CreationTimestamp.Unix(),
+		DeployedBy: service.Annotations[annotationDeployedBy],
+		Settings:   toPbSettings(&service.Spec),
+		Traffic:    toPbTraffic(service),
+	}
+}
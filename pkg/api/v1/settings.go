@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// validateSettings checks that settings make sense on their own and fit into the limits of the environment.
+// The limits are the limit ranges and resource quotas of the namespace.
+// Quotas only count what isn't used up by the namespace already.
+func (s *CliServer) validateSettings(ctx context.Context, namespaceName string, settings *pb.ServiceSettings) error {
+	if settings == nil {
+		return nil
+	}
+
+	if settings.MinReplicas < 0 || settings.MaxReplicas < 0 || settings.Concurrency < 0 {
+		return fmt.Errorf("%w: replicas and concurrency can't be negative", ErrInvalidArgument)
+	}
+	if settings.MaxReplicas > 0 && settings.MinReplicas > settings.MaxReplicas {
+		return fmt.Errorf("%w: min replicas %d are more than max replicas %d", ErrInvalidArgument, settings.MinReplicas, settings.MaxReplicas)
+	}
+
+	requested := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]string{corev1.ResourceCPU: settings.CPU, corev1.ResourceMemory: settings.Memory} {
+		if value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return fmt.Errorf("%w: %s %s: %s", ErrInvalidArgument, name, value, err.Error())
+		}
+		if quantity.Sign() <= 0 {
+			return fmt.Errorf("%w: %s needs to be positive", ErrInvalidArgument, name)
+		}
+		requested[name] = quantity
+	}
+	if len(requested) == 0 {
+		return nil
+	}
+
+	limitRanges, err := s.k8sClient.CoreV1().LimitRanges(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, limitRange := range limitRanges.Items {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1.LimitTypeContainer {
+				continue
+			}
+			for name, quantity := range requested {
+				if max, ok := item.Max[name]; ok && quantity.Cmp(max) > 0 {
+					return fmt.Errorf("%w: %s %s is more than the maximum of %s in namespace %s", ErrInvalidArgument, name, quantity.String(), max.String(), namespaceName)
+				}
+				if min, ok := item.Min[name]; ok && quantity.Cmp(min) < 0 {
+					return fmt.Errorf("%w: %s %s is less than the minimum of %s in namespace %s", ErrInvalidArgument, name, quantity.String(), min.String(), namespaceName)
+				}
+			}
+		}
+	}
+
+	// without an upper bound on replicas there's no telling whether a quota will be hit
+	if settings.MaxReplicas == 0 {
+		return nil
+	}
+
+	quotas, err := s.k8sClient.CoreV1().ResourceQuotas(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, quota := range quotas.Items {
+		for name, quantity := range requested {
+			total := quantity.MilliValue() * int64(settings.MaxReplicas)
+			for _, quotaName := range []corev1.ResourceName{name, "requests." + name, "limits." + name} {
+				hard, ok := quota.Spec.Hard[quotaName]
+				if !ok {
+					continue
+				}
+				used := quota.Status.Used[quotaName]
+				if total > hard.MilliValue()-used.MilliValue() {
+					return fmt.Errorf("%w: %d replicas with %s %s exceed the quota of %s in namespace %s, %s of it are used already", ErrInvalidArgument, settings.MaxReplicas, name, quantity.String(), hard.String(), namespaceName, used.String())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// setSettings replaces the resources and scaling of a service.
+// Settings left at zero stay unset, the operator falls back to its defaults for those.
+// CPU and memory are requested and capped at the same amount.
+func setSettings(svc *v1alpha1.Service, settings *pb.ServiceSettings) {
+	resources := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]string{corev1.ResourceCPU: settings.CPU, corev1.ResourceMemory: settings.Memory} {
+		if value != "" {
+			// validateSettings made sure the quantities parse
+			resources[name] = resource.MustParse(value)
+		}
+	}
+	svc.Spec.Resources = corev1.ResourceRequirements{}
+	if len(resources) > 0 {
+		svc.Spec.Resources.Requests = resources
+		svc.Spec.Resources.Limits = resources.DeepCopy()
+	}
+
+	svc.Spec.Scaling = v1alpha1.Scaling{
+		MinReplicas: settings.MinReplicas,
+		MaxReplicas: settings.MaxReplicas,
+		Concurrency: int64(settings.Concurrency),
+	}
+}
+
+// toPbSettings reads the resources and scaling of a service back from its spec.
+func toPbSettings(spec *v1alpha1.ServiceSpec) *pb.ServiceSettings {
+	settings := &pb.ServiceSettings{
+		MinReplicas: spec.Scaling.MinReplicas,
+		MaxReplicas: spec.Scaling.MaxReplicas,
+		Concurrency: int32(spec.Scaling.Concurrency),
+	}
+	if cpu, ok := spec.Resources.Requests[corev1.ResourceCPU]; ok {
+		settings.CPU = cpu.String()
+	}
+	if memory, ok := spec.Resources.Requests[corev1.ResourceMemory]; ok {
+		settings.Memory = memory.String()
+	}
+	return settings
+}
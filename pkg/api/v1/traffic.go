@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+	"github.com/mhelmich/haiku-operator/apis/serving/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// trafficTarget is a validated traffic target of a deploy or traffic request.
+// Targets pointing to previous revisions carry the name the revision has in the haiku service,
+// so that the operator routes to that very revision (with its image, env and settings).
+type trafficTarget struct {
+	Revision       int64
+	RevisionName   string
+	LatestRevision bool
+	Percent        int64
+	Tag            string
+}
+
+// all traffic goes to the latest revision unless told otherwise
+var defaultTraffic = []trafficTarget{
+	{
+		LatestRevision: true,
+		Percent:        100,
+	},
+}
+
+// SetTraffic shifts traffic between revisions of a service without deploying anything.
+func (s *CliServer) SetTraffic(ctx context.Context, req *pb.SetTrafficRequest) (*pb.SetTrafficReply, error) {
+	namespaceName, err := s.environmentNamespace(ctx, req.EnvironmentName)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.WithValues("namespaceName", namespaceName, "serviceName", req.ServiceName, "requestID", requestid.FromContext(ctx))
+	logger.Info("set traffic")
+	targets, err := s.resolveTraffic(ctx, namespaceName, req.ServiceName, req.Traffic)
+	if err != nil {
+		logger.Error(err, "invalid traffic")
+		return nil, err
+	}
+
+	service, err := s.updateService(ctx, namespaceName, req.ServiceName, func(svc *v1alpha1.Service) {
+		setTraffic(svc, targets)
+	})
+	if err != nil && IsNotFound(err) {
+		logger.Info("service doesn't exist")
+		return nil, err
+	} else if err != nil {
+		logger.Error(err, "failed to update service")
+		return nil, err
+	}
+
+	// tagged URLs are only known once the operator applied the traffic
+	service, err = s.waitForService(ctx, service, logger)
+	if err != nil {
+		logger.Error(err, "failed to watch service")
+		return nil, err
+	}
+
+	return &pb.SetTrafficReply{
+		URL:     service.Status.URL,
+		Traffic: toPbTraffic(service),
+	}, nil
+}
+
+// resolveTraffic validates traffic targets and looks up the revisions they point to.
+// No targets at all means all traffic goes to the latest revision.
+func (s *CliServer) resolveTraffic(ctx context.Context, namespaceName string, serviceName string, traffic []*pb.TrafficTarget) ([]trafficTarget, error) {
+	if len(traffic) == 0 {
+		return defaultTraffic, nil
+	}
+
+	var total int64
+	tags := map[string]bool{}
+	targets := make([]trafficTarget, len(traffic))
+	for idx, t := range traffic {
+		if t.Percent < 0 || t.Percent > 100 {
+			return nil, fmt.Errorf("%w: percent needs to be between 0 and 100", ErrInvalidArgument)
+		}
+		total += t.Percent
+
+		if t.Tag != "" {
+			if errs := validation.IsDNS1123Label(t.Tag); len(errs) > 0 {
+				return nil, fmt.Errorf("%w: tag %s: %s", ErrInvalidArgument, t.Tag, strings.Join(errs, ", "))
+			}
+			if tags[t.Tag] {
+				return nil, fmt.Errorf("%w: tag %s is used twice", ErrInvalidArgument, t.Tag)
+			}
+			tags[t.Tag] = true
+		} else if t.Percent == 0 {
+			return nil, fmt.Errorf("%w: a target without traffic needs a tag", ErrInvalidArgument)
+		}
+
+		targets[idx] = trafficTarget{
+			LatestRevision: t.LatestRevision,
+			Percent:        t.Percent,
+			Tag:            t.Tag,
+		}
+		if t.LatestRevision {
+			if t.Revision != 0 {
+				return nil, fmt.Errorf("%w: a target either points to the latest revision or to a particular one", ErrInvalidArgument)
+			}
+			continue
+		}
+
+		cm, err := s.getRevision(ctx, namespaceName, serviceName, t.Revision)
+		if err != nil {
+			return nil, err
+		}
+		var spec v1alpha1.ServiceSpec
+		err = json.Unmarshal([]byte(cm.Data[revisionDataSpec]), &spec)
+		if err != nil || spec.RevisionName == "" {
+			return nil, fmt.Errorf("%w: revision %d can't get traffic, roll back to it instead", ErrInvalidArgument, t.Revision)
+		}
+		targets[idx].Revision = t.Revision
+		targets[idx].RevisionName = spec.RevisionName
+	}
+
+	if total != 100 {
+		return nil, fmt.Errorf("%w: traffic needs to add up to 100 percent but is %d", ErrInvalidArgument, total)
+	}
+	return targets, nil
+}
+
+// setTraffic replaces the traffic split of a service.
+// The default traffic doesn't need a split, the operator sends everything to the latest revision.
+func setTraffic(svc *v1alpha1.Service, targets []trafficTarget) {
+	if isDefaultTraffic(targets) {
+		svc.Spec.Traffic = nil
+		return
+	}
+
+	svc.Spec.Traffic = make([]v1alpha1.TrafficTarget, len(targets))
+	for idx, t := range targets {
+		latestRevision, percent := t.LatestRevision, t.Percent
+		svc.Spec.Traffic[idx] = v1alpha1.TrafficTarget{
+			RevisionName:   t.RevisionName,
+			LatestRevision: &latestRevision,
+			Percent:        &percent,
+			Tag:            t.Tag,
+		}
+	}
+}
+
+func isDefaultTraffic(targets []trafficTarget) bool {
+	return len(targets) == 1 && targets[0].LatestRevision && targets[0].Percent == 100 && targets[0].Tag == ""
+}
+
+// toPbTraffic describes how the traffic of a service is split.
+// The operator resolves revisions and tagged URLs in the status,
+// the spec is only used as long as the status lags behind.
+func toPbTraffic(service *v1alpha1.Service) []*pb.TrafficTarget {
+	targets := service.Status.Traffic
+	if service.Status.ObservedGeneration < service.Generation || len(targets) == 0 {
+		targets = service.Spec.Traffic
+	}
+	if len(targets) == 0 {
+		return []*pb.TrafficTarget{
+			{
+				Revision:       revisionFromKnativeName(service.Name, service.Spec.RevisionName),
+				LatestRevision: true,
+				Percent:        100,
+			},
+		}
+	}
+
+	traffic := make([]*pb.TrafficTarget, len(targets))
+	for idx, t := range targets {
+		traffic[idx] = &pb.TrafficTarget{
+			Revision:       revisionFromKnativeName(service.Name, t.RevisionName),
+			LatestRevision: t.LatestRevision != nil && *t.LatestRevision,
+			Tag:            t.Tag,
+			URL:            t.URL,
+		}
+		if t.Percent != nil {
+			traffic[idx].Percent = *t.Percent
+		}
+	}
+	return traffic
+}
+
+// taggedURL returns the URL the operator reports for a tag of a service.
+// It's empty as long as the operator didn't get to the tag yet.
+func taggedURL(service *v1alpha1.Service, tag string) string {
+	for _, t := range service.Status.Traffic {
+		if t.Tag == tag {
+			return t.URL
+		}
+	}
+	return ""
+}
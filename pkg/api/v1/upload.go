@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/mhelmich/haiku-api/pkg/api/v1/pb"
+	"github.com/mhelmich/haiku-api/pkg/requestid"
+)
+
+const (
+	// the bucket archives are uploaded to, can be overridden with the env var
+	uploadBucketEnvVar  = "HAIKU_UPLOAD_BUCKET"
+	defaultUploadBucket = "haiku_service_storage"
+
+	archiveContentType = "application/zip"
+	maxArchiveBytes    = int64(512 << 20)
+	// a progress update is sent whenever that many bytes came in
+	progressInterval = int64(1 << 20)
+)
+
+// metadata of uploaded archives in object storage
+const (
+	objectMetadataRequester = "requester"
+	objectMetadataRequestID = "request-id"
+	objectMetadataChecksum  = "sha256"
+)
+
+func uploadBucketFromEnv() string {
+	if bucket := os.Getenv(uploadBucketEnvVar); bucket != "" {
+		return bucket
+	}
+	return defaultUploadBucket
+}
+
+// uploadArchive streams the chunks that follow the metadata of an Up call into object storage.
+// The object only comes into existence if the whole archive made it, wasn't too big and matches the checksum.
+// Progress is reported while the archive comes in, whether it made it is up to the caller to report.
+// It returns the key of the object.
+func (s *CliServer) uploadArchive(stream pb.CliService_UpServer, md *pb.MetaData, logger logr.Logger) (string, error) {
+	// cancelling the context before the writer is closed drops whatever was written so far
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	key := getUrlUploadKey(md.EnvironmentName, md.ServiceName)
+	writer := s.gcsClient.Bucket(s.uploadBucket).Object(key).NewWriter(ctx)
+	writer.ContentType = archiveContentType
+	writer.Metadata = map[string]string{
+		objectMetadataRequester: callerFromContext(ctx),
+		objectMetadataRequestID: requestid.FromContext(ctx),
+	}
+	if md.Checksum != "" {
+		writer.Metadata[objectMetadataChecksum] = strings.ToLower(md.Checksum)
+	}
+
+	hash := sha256.New()
+	var received, reported int64
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+
+		chunk := req.GetChunk()
+		received += int64(len(chunk))
+		if received > maxArchiveBytes {
+			return "", fmt.Errorf("%w: archive is larger than %d bytes", ErrInvalidArgument, maxArchiveBytes)
+		}
+
+		hash.Write(chunk)
+		_, err = writer.Write(chunk)
+		if err != nil {
+			return "", err
+		}
+
+		if received-reported >= progressInterval {
+			reported = received
+			err = sendUploadProgress(stream, pb.UploadStatus_IN_PROGRESS, received, key)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if md.Checksum != "" && !strings.EqualFold(md.Checksum, checksum) {
+		return "", fmt.Errorf("%w: checksum of the archive is %s but should be %s", ErrInvalidArgument, checksum, md.Checksum)
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return "", err
+	}
+	logger.Info("archive uploaded", "key", key, "bytes", received)
+
+	// the caller reports the end of the upload
+	return key, nil
+}
+
+func sendUploadProgress(stream pb.CliService_UpServer, status pb.UploadStatus, received int64, key string) error {
+	return stream.Send(&pb.UpResponse{
+		Data: &pb.UpResponse_UploadProgress{
+			UploadProgress: &pb.UploadProgress{
+				Status:        status,
+				BytesReceived: received,
+				Key:           key,
+			},
+		},
+	})
+}
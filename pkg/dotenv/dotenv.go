@@ -0,0 +1,129 @@
+package dotenv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Parse reads a dotenv payload.
+// It understands:
+// * blank lines and lines starting with "#"
+// * an optional "export " prefix
+// * unquoted values (trailing " #" comments are stripped)
+// * single quoted values (taken literally, may span lines)
+// * double quoted values (with \n, \r, \t, \", \\ escapes, may span lines)
+// Later definitions of a key win over earlier ones.
+func Parse(payload string) (map[string]string, error) {
+	env := map[string]string{}
+	lines := strings.Split(strings.ReplaceAll(payload, "\r\n", "\n"), "\n")
+	for idx := 0; idx < len(lines); idx++ {
+		lineNumber := idx + 1
+		// only leading whitespace goes, a quoted value keeps its raw text up to the closing quote
+		line := strings.TrimLeft(lines[idx], " \t")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+		pos := strings.Index(line, "=")
+		if pos < 0 {
+			return nil, fmt.Errorf("line %d: missing '='", lineNumber)
+		}
+
+		key := strings.TrimSpace(line[:pos])
+		if key == "" {
+			return nil, fmt.Errorf("line %d: missing key", lineNumber)
+		}
+
+		rest := strings.TrimLeft(line[pos+1:], " \t")
+		if rest == "" || (rest[0] != '"' && rest[0] != '\'') {
+			env[key] = stripComment(rest)
+			continue
+		}
+
+		// quoted values may span multiple lines
+		quote := rest[0]
+		raw := rest[1:]
+		for !hasClosingQuote(raw, quote) {
+			idx++
+			if idx >= len(lines) {
+				return nil, fmt.Errorf("line %d: unterminated quoted value", lineNumber)
+			}
+			raw += "\n" + lines[idx]
+		}
+
+		end := closingQuote(raw, quote)
+		value := raw[:end]
+		if quote == '"' {
+			value = unescape(value)
+		}
+
+		trailing := strings.TrimSpace(raw[end+1:])
+		if trailing != "" && !strings.HasPrefix(trailing, "#") {
+			return nil, fmt.Errorf("line %d: unexpected characters after quoted value", lineNumber)
+		}
+		env[key] = value
+	}
+	return env, nil
+}
+
+// Format writes env as a dotenv payload with keys in sorted order.
+// Values are double quoted whenever they wouldn't survive a round trip otherwise.
+func Format(env map[string]string) string {
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		sb.WriteString(key)
+		sb.WriteString("=")
+		sb.WriteString(quote(env[key]))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func stripComment(value string) string {
+	if pos := strings.Index(value, " #"); pos >= 0 {
+		value = value[:pos]
+	}
+	return strings.TrimSpace(value)
+}
+
+func hasClosingQuote(raw string, quote byte) bool {
+	return closingQuote(raw, quote) >= 0
+}
+
+// closingQuote returns the position of the first unescaped quote character.
+// Single quoted values don't know escapes.
+func closingQuote(raw string, quote byte) int {
+	for idx := 0; idx < len(raw); idx++ {
+		if quote == '"' && raw[idx] == '\\' {
+			idx++
+			continue
+		}
+		if raw[idx] == quote {
+			return idx
+		}
+	}
+	return -1
+}
+
+var unescaper = strings.NewReplacer(`\n`, "\n", `\r`, "\r", `\t`, "\t", `\"`, `"`, `\\`, `\`)
+
+func unescape(value string) string {
+	return unescaper.Replace(value)
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+func quote(value string) string {
+	if value == "" || !strings.ContainsAny(value, " \t\r\n#\"'\\=") {
+		return value
+	}
+	return `"` + escaper.Replace(value) + `"`
+}
@@ -0,0 +1,164 @@
+package dotenv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			payload: "",
+			want:    map[string]string{},
+		},
+		{
+			name:    "plain values",
+			payload: "A=1\nB=two\n",
+			want:    map[string]string{"A": "1", "B": "two"},
+		},
+		{
+			name:    "windows line endings",
+			payload: "A=1\r\nB=2\r\n",
+			want:    map[string]string{"A": "1", "B": "2"},
+		},
+		{
+			name:    "blank lines and comments",
+			payload: "# leading comment\n\n  # indented comment\nA=1\n\n",
+			want:    map[string]string{"A": "1"},
+		},
+		{
+			name:    "trailing comment on unquoted value",
+			payload: "A=1 # the answer\nB=a#b",
+			want:    map[string]string{"A": "1", "B": "a#b"},
+		},
+		{
+			name:    "whitespace around key and value",
+			payload: "  A = 1  \n\tB=\t2",
+			want:    map[string]string{"A": "1", "B": "2"},
+		},
+		{
+			name:    "export prefix",
+			payload: "export A=1\n  export B=\"2\"",
+			want:    map[string]string{"A": "1", "B": "2"},
+		},
+		{
+			name:    "empty value",
+			payload: "A=\nB=\"\"",
+			want:    map[string]string{"A": "", "B": ""},
+		},
+		{
+			name:    "single quotes are literal",
+			payload: `A='a\nb # not a comment'`,
+			want:    map[string]string{"A": `a\nb # not a comment`},
+		},
+		{
+			name:    "double quote escapes",
+			payload: `A="tab\there\nnew line \"quoted\" back\\slash"`,
+			want:    map[string]string{"A": "tab\there\nnew line \"quoted\" back\\slash"},
+		},
+		{
+			name:    "comment after quoted value",
+			payload: `A="1 # kept" # dropped`,
+			want:    map[string]string{"A": "1 # kept"},
+		},
+		{
+			name:    "multi line double quoted value keeps its whitespace",
+			payload: "A=\"abc   \n  def\"\nB=1",
+			want:    map[string]string{"A": "abc   \n  def", "B": "1"},
+		},
+		{
+			name:    "multi line single quoted value",
+			payload: "A='first\nsecond'",
+			want:    map[string]string{"A": "first\nsecond"},
+		},
+		{
+			name:    "later keys win",
+			payload: "A=1\nA=2",
+			want:    map[string]string{"A": "2"},
+		},
+		{
+			name:    "missing equals sign",
+			payload: "A",
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			payload: "=1",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated quote",
+			payload: "A=\"1\nB=2",
+			wantErr: true,
+		},
+		{
+			name:    "characters after quoted value",
+			payload: `A="1"2`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.payload)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Parse(%q) = %v, want an error", tt.payload, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.payload, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %q, want %q", tt.payload, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{
+			name: "plain values stay unquoted",
+			env:  map[string]string{"B": "2", "A": "1"},
+			want: "A=1\nB=2\n",
+		},
+		{
+			name: "values that need quoting",
+			env:  map[string]string{"A": "with space", "B": "multi\nline", "C": `say "hi"`, "D": "a#b"},
+			want: "A=\"with space\"\nB=\"multi\\nline\"\nC=\"say \\\"hi\\\"\"\nD=\"a#b\"\n",
+		},
+		{
+			name: "empty value",
+			env:  map[string]string{"A": ""},
+			want: "A=\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Format(tt.env)
+			if got != tt.want {
+				t.Fatalf("Format(%q) = %q, want %q", tt.env, got, tt.want)
+			}
+			parsed, err := Parse(got)
+			if err != nil {
+				t.Fatalf("Parse(Format(%q)) failed: %v", tt.env, err)
+			}
+			if !reflect.DeepEqual(parsed, tt.env) {
+				t.Errorf("Parse(Format(%q)) = %q", tt.env, parsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package manifest
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// APIVersion is the only version of the manifest format there is so far.
+	// Breaking changes to the format get a new version.
+	APIVersion = "haiku.io/v1"
+	Kind       = "Environment"
+)
+
+// Manifest describes an environment as it's checked into git, usually as haiku.yaml.
+//
+//	apiVersion: haiku.io/v1
+//	kind: Environment
+//	metadata:
+//	  name: staging
+//	spec:
+//	  tier: small
+//	  services:
+//	  - name: api
+//	    image: gcr.io/acme/api:1.2.3
+//	    env:
+//	      LOG_LEVEL: info
+//	    secretEnv:
+//	      DB_PASSWORD: sha256:...
+//	    scaling:
+//	      cpu: 500m
+//	      memory: 256Mi
+//	      minReplicas: 1
+//	      maxReplicas: 5
+//	      concurrency: 80
+type Manifest struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Metadata   Metadata `json:"metadata"`
+	Spec       Spec     `json:"spec"`
+}
+
+type Metadata struct {
+	Name string `json:"name"`
+}
+
+type Spec struct {
+	Tier     string    `json:"tier,omitempty"`
+	Services []Service `json:"services,omitempty"`
+}
+
+type Service struct {
+	Name  string            `json:"name"`
+	Image string            `json:"image"`
+	Env   map[string]string `json:"env,omitempty"`
+	// Values are either the secret itself or its "sha256:<hex>" hash.
+	// A hash only works for secrets the service has already.
+	SecretEnv map[string]string `json:"secretEnv,omitempty"`
+	Scaling   *Scaling          `json:"scaling,omitempty"`
+}
+
+type Scaling struct {
+	CPU         string `json:"cpu,omitempty"`
+	Memory      string `json:"memory,omitempty"`
+	MinReplicas int32  `json:"minReplicas,omitempty"`
+	MaxReplicas int32  `json:"maxReplicas,omitempty"`
+	Concurrency int32  `json:"concurrency,omitempty"`
+}
+
+// Parse reads a manifest and checks that it's one we understand.
+// Unknown fields are rejected, they are most likely typos.
+func Parse(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	err := yaml.UnmarshalStrict(data, m)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.APIVersion != APIVersion {
+		return nil, fmt.Errorf("unsupported apiVersion %q, expected %q", m.APIVersion, APIVersion)
+	}
+	if m.Kind != Kind {
+		return nil, fmt.Errorf("unsupported kind %q, expected %q", m.Kind, Kind)
+	}
+	if m.Metadata.Name == "" {
+		return nil, fmt.Errorf("metadata.name is missing")
+	}
+
+	names := map[string]bool{}
+	for idx, svc := range m.Spec.Services {
+		if svc.Name == "" {
+			return nil, fmt.Errorf("spec.services[%d].name is missing", idx)
+		}
+		if svc.Image == "" {
+			return nil, fmt.Errorf("spec.services[%d].image is missing", idx)
+		}
+		if names[svc.Name] {
+			return nil, fmt.Errorf("service %s is defined twice", svc.Name)
+		}
+		names[svc.Name] = true
+	}
+	return m, nil
+}
+
+// Marshal renders a manifest as yaml.
+func Marshal(m *Manifest) ([]byte, error) {
+	return yaml.Marshal(m)
+}
@@ -0,0 +1,244 @@
+package manifest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    *Manifest
+		wantErr bool
+	}{
+		{
+			name: "minimal",
+			payload: `
+apiVersion: haiku.io/v1
+kind: Environment
+metadata:
+  name: staging
+`,
+			want: &Manifest{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Metadata:   Metadata{Name: "staging"},
+			},
+		},
+		{
+			name: "everything",
+			payload: `
+apiVersion: haiku.io/v1
+kind: Environment
+metadata:
+  name: staging
+spec:
+  tier: small
+  services:
+  - name: api
+    image: gcr.io/acme/api:1.2.3
+    env:
+      LOG_LEVEL: info
+    secretEnv:
+      DB_PASSWORD: hunter2
+    scaling:
+      cpu: "0.5"
+      memory: 256Mi
+      minReplicas: 1
+      maxReplicas: 5
+      concurrency: 80
+  - name: worker
+    image: gcr.io/acme/worker:1.2.3
+`,
+			want: &Manifest{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Metadata:   Metadata{Name: "staging"},
+				Spec: Spec{
+					Tier: "small",
+					Services: []Service{
+						{
+							Name:      "api",
+							Image:     "gcr.io/acme/api:1.2.3",
+							Env:       map[string]string{"LOG_LEVEL": "info"},
+							SecretEnv: map[string]string{"DB_PASSWORD": "hunter2"},
+							Scaling: &Scaling{
+								CPU:         "0.5",
+								Memory:      "256Mi",
+								MinReplicas: 1,
+								MaxReplicas: 5,
+								Concurrency: 80,
+							},
+						},
+						{
+							Name:  "worker",
+							Image: "gcr.io/acme/worker:1.2.3",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "not yaml",
+			payload: "apiVersion: [",
+			wantErr: true,
+		},
+		{
+			name: "unknown field",
+			payload: `
+apiVersion: haiku.io/v1
+kind: Environment
+metadata:
+  name: staging
+spec:
+  teir: small
+`,
+			wantErr: true,
+		},
+		{
+			name: "wrong api version",
+			payload: `
+apiVersion: haiku.io/v2
+kind: Environment
+metadata:
+  name: staging
+`,
+			wantErr: true,
+		},
+		{
+			name: "wrong kind",
+			payload: `
+apiVersion: haiku.io/v1
+kind: Service
+metadata:
+  name: staging
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing name",
+			payload: `
+apiVersion: haiku.io/v1
+kind: Environment
+`,
+			wantErr: true,
+		},
+		{
+			name: "service without name",
+			payload: `
+apiVersion: haiku.io/v1
+kind: Environment
+metadata:
+  name: staging
+spec:
+  services:
+  - image: gcr.io/acme/api:1.2.3
+`,
+			wantErr: true,
+		},
+		{
+			name: "service without image",
+			payload: `
+apiVersion: haiku.io/v1
+kind: Environment
+metadata:
+  name: staging
+spec:
+  services:
+  - name: api
+`,
+			wantErr: true,
+		},
+		{
+			name: "service defined twice",
+			payload: `
+apiVersion: haiku.io/v1
+kind: Environment
+metadata:
+  name: staging
+spec:
+  services:
+  - name: api
+    image: gcr.io/acme/api:1.2.3
+  - name: api
+    image: gcr.io/acme/api:1.2.4
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.payload))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// An exported manifest applied as is must not change anything,
+// so whatever Marshal renders has to parse back into the same manifest.
+func TestMarshalRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		m    *Manifest
+	}{
+		{
+			name: "empty environment",
+			m: &Manifest{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Metadata:   Metadata{Name: "staging"},
+			},
+		},
+		{
+			name: "services",
+			m: &Manifest{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Metadata:   Metadata{Name: "staging"},
+				Spec: Spec{
+					Tier: "small",
+					Services: []Service{
+						{
+							Name:      "api",
+							Image:     "gcr.io/acme/api@sha256:0123456789abcdef",
+							Env:       map[string]string{"LOG_LEVEL": "info", "EMPTY": ""},
+							SecretEnv: map[string]string{"DB_PASSWORD": "sha256:0123456789abcdef"},
+							Scaling: &Scaling{
+								CPU:         "500m",
+								Memory:      "256Mi",
+								MaxReplicas: 5,
+							},
+						},
+						{
+							Name:  "worker",
+							Image: "gcr.io/acme/worker:1.2.3",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Marshal(tt.m)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			got, err := Parse(data)
+			if err != nil {
+				t.Fatalf("Parse() error = %v\n%s", err, data)
+			}
+			if !reflect.DeepEqual(got, tt.m) {
+				t.Errorf("Parse(Marshal()) = %+v, want %+v", got, tt.m)
+			}
+		})
+	}
+}
@@ -48,6 +48,12 @@ func StreamServerInterceptor(opt ...Option) grpc.StreamServerInterceptor {
 	}
 }
 
+// NewContext attaches a new request id to a context that didn't come in through gRPC,
+// think background jobs.
+func NewContext(ctx context.Context) context.Context {
+	return setIncoming(ctx, newDefaultRequestID())
+}
+
 func FromContext(ctx context.Context) string {
 	id, ok := ctx.Value(reqIDKey).(string)
 	if !ok {